@@ -24,7 +24,7 @@ var BasicAuth = BasicAuthSecurity("basic", func() {
 
 // OAuth2Auth defines a security scheme that uses OAuth2 tokens.
 var OAuth2Auth = OAuth2Security("oauth2", func() {
-	AuthorizationCodeFlow("http://0.0.0.0/authorization", "http://0.0.0.0/token", "http://0.0.0.0/refresh")
+	AuthorizationCodeFlow("http://localhost:8085/v1/oauth/authorize", "http://localhost:8085/v1/oauth/token", "http://localhost:8085/v1/oauth/token")
 	Description(`Secures endpoint by requiring a valid OAuth2 token retrieved via the signin endpoint. Supports scopes "api:read" and "api:write".`)
 	Scope("api:read", "Read-only access")
 	Scope("api:write", "Read and write access")
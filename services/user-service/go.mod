@@ -6,6 +6,7 @@ require (
 	github.com/LensPlatform/Lens v0.0.0-20200403075548-c8ac151c0a5f // indirect
 	github.com/alexflint/go-arg v1.3.0
 	github.com/cosmtrek/air v1.12.1 // indirect
+	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/fatih/color v1.9.0 // indirect
 	github.com/go-kit/kit v0.10.0
 	github.com/golang/protobuf v1.4.0-rc.4
@@ -33,6 +33,7 @@ import (
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
 	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
 	"github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
 	"github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
@@ -69,9 +70,84 @@ func main() {
 	// connect to rabbitmq
 	amqpproducerconn, amqpconsumerconn := queues.Init(zapLogger)
 
-	httpHandler := InitService(zapLogger, db, amqpproducerconn, amqpconsumerconn, counters, tracer, zipkinTracer)
+	// forwarding headers are only honoured when set by one of the trusted reverse proxies
+	if _, err := auth.ParseTrustedProxies(config.Config.TrustedProxies); err != nil {
+		zapLogger.Error(err.Error(), zap.String("Configuration Error", "Invalid Trusted Proxy Range"))
+		os.Exit(1)
+	}
+
+	// secrets persisted by the service, such as two factor secrets and signing keys, are encrypted at rest
+	// under a key which must be supplied by the deployment
+	if config.Config.EncryptionKey == "" {
+		zapLogger.Error("ENCRYPTION_KEY is not set", zap.String("Configuration Error", "Missing Encryption Key"))
+		os.Exit(1)
+	}
+
+	secrets, err := auth.NewCipher(config.Config.EncryptionKey)
+	if err != nil {
+		zapLogger.Error(err.Error(), zap.String("Encryption Error", "Unable To Initialize Cipher"))
+		os.Exit(1)
+	}
+
+	// load the asymmetric keys tokens are signed with
+	keys, err := auth.NewKeySet(&postgresql.Database{Engine: db, Logger: zapLogger}, secrets, zapLogger, config.Config)
+	if err == nil {
+		err = keys.Load()
+	}
+	if err != nil {
+		zapLogger.Error(err.Error(), zap.String("Signing Key Error", "Unable To Load Signing Keys"))
+		os.Exit(1)
+	}
+
+	// passwords are hashed with the configured algorithm, outdated hashes are upgraded on log in
+	passwords, err := auth.NewPasswordHasher(config.Config)
+	if err != nil {
+		zapLogger.Error(err.Error(), zap.String("Password Hashing Error", "Unable To Initialize Password Hasher"))
+		os.Exit(1)
+	}
+
+	// passwords must satisfy the configured policy, including the breached password list if any
+	policy, err := auth.NewPasswordPolicy(config.Config)
+	if err != nil {
+		zapLogger.Error(err.Error(), zap.String("Password Policy Error", "Unable To Load Password Policy"))
+		os.Exit(1)
+	}
+
+	httpHandler := InitService(zapLogger, db, keys, secrets, passwords, policy, amqpproducerconn, amqpconsumerconn, counters, tracer, zipkinTracer)
 
 	g := MountListeners(zapLogger, httpHandler)
+	{
+		// The key rotation routine rotates the signing key on schedule and purges expired keys.
+		done := make(chan struct{})
+		g.Add(func() error {
+			keys.Run(done)
+			return nil
+		}, func(error) {
+			close(done)
+		})
+	}
+	{
+		// The purge routine permanently deletes users once their deletion grace period has elapsed.
+		purger := service.NewUserPurger(&postgresql.Database{Engine: db, Logger: zapLogger}, zapLogger, config.Config)
+		done := make(chan struct{})
+		g.Add(func() error {
+			purger.Run(done)
+			return nil
+		}, func(error) {
+			close(done)
+		})
+	}
+	{
+		// The export routine builds the data exports requested by users and notifies them once ready.
+		exporter := service.NewDataExporter(&postgresql.Database{Engine: db, Logger: zapLogger}, amqpproducerconn, zapLogger, config.Config)
+		done := make(chan struct{})
+		g.Add(func() error {
+			exporter.Run(done)
+			return nil
+		}, func(error) {
+			close(done)
+		})
+	}
 	{
 		// This function just sits and waits for ctrl-C.
 		cancelInterrupt := make(chan struct{})
@@ -142,14 +218,17 @@ func MountListeners(zapLogger *zap.Logger, httpHandler http.Handler) group.Group
 // and finally, a series of concrete transport adapters. The adapters, like
 // the HTTP handler or the gRPC server, are the bridge between Go kit and
 // the interfaces that the transports expect
-func InitService(zapLogger *zap.Logger, db *gorm.DB, amqpproducerconn queues.Queue,
-	amqpconsumerconn queues.Queue, counter service.Counters,
+func InitService(zapLogger *zap.Logger, db *gorm.DB, keys *auth.KeySet, secrets *auth.Cipher, passwords auth.PasswordHasher,
+	policy *auth.PasswordPolicy, amqpproducerconn queues.Queue, amqpconsumerconn queues.Queue, counter service.Counters,
 	tracer stdopentracing.Tracer, zipkinTracer *zipkin.Tracer) http.Handler {
 
 	var (
-		svc         = service.New(zapLogger, db, amqpproducerconn, amqpconsumerconn, counter)
-		endpoints   = endpoint.New(svc, zapLogger, counter.Duration, tracer, zipkinTracer)
-		httpHandler = transport.NewHTTPHandler(svc, endpoints, counter.Duration, tracer, zipkinTracer, zapLogger)
+		tokens        = auth.NewTokenManager(config.Config, keys, &postgresql.Database{Engine: db, Logger: zapLogger})
+		apiKeys       = auth.NewAPIKeyAuthenticator(&postgresql.Database{Engine: db, Logger: zapLogger})
+		authenticator = auth.Chain(tokens, apiKeys)
+		svc           = service.New(zapLogger, db, tokens, secrets, passwords, policy, amqpproducerconn, amqpconsumerconn, counter)
+		endpoints     = endpoint.New(svc, authenticator, zapLogger, counter.Duration, tracer, zipkinTracer)
+		httpHandler   = transport.NewHTTPHandler(svc, endpoints, counter.Duration, tracer, zipkinTracer, zapLogger)
 	)
 	return httpHandler
 }
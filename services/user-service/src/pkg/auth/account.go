@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/go-kit/kit/transport/http"
+	atlasauth "github.com/infobloxopen/atlas-app-toolkit/auth"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+)
+
+// authorizationMetadataKey is the metadata key the generated gorm handlers look bearer tokens up with
+const authorizationMetadataKey = "authorization"
+
+// NewAccountContext returns a copy of the context scoped to a given account. The generated multi
+// account gorm handlers resolve the account from the account_id claim of a bearer token carried by
+// the incoming grpc metadata, without verifying it, hence the account is carried as an unsigned token
+func NewAccountContext(ctx context.Context, accountID string) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{atlasauth.MultiTenancyField: accountID}).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		return ctx
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(authorizationMetadataKey, atlasauth.DefaultTokenType+" "+token)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// AccountFromContext obtains the account a context is scoped to if any
+func AccountFromContext(ctx context.Context) (string, bool) {
+	accountID, err := atlasauth.GetAccountID(ctx, nil)
+	if err != nil {
+		return "", false
+	}
+	return accountID, true
+}
+
+// HTTPAccountToContext scopes the request context to the default account. Requests are scoped to
+// the account of the principal instead once the caller is authenticated
+func HTTPAccountToContext() http.RequestFunc {
+	return func(ctx context.Context, r *stdhttp.Request) context.Context {
+		if config.Config == nil || config.Config.DefaultAccountID == "" {
+			return ctx
+		}
+		return NewAccountContext(ctx, config.Config.DefaultAccountID)
+	}
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	stdhttp "net/http"
+	"time"
+
+	"github.com/go-kit/kit/transport/http"
+	"github.com/jinzhu/gorm"
+
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+const (
+	// APIKeyContextKey holds the key used to store an api key in the context
+	APIKeyContextKey contextKey = "APIKey"
+
+	// APIKeyHeader is the request header carrying api keys
+	APIKeyHeader = "X-API-Key"
+
+	// apiKeyPrefix is prepended to every issued api key so that leaked keys are easy to spot
+	apiKeyPrefix = "lens_"
+)
+
+// NewAPIKey generates a new api key as well as the short prefix used to identify it
+func NewAPIKey() (key string, prefix string, err error) {
+	token, err := NewOpaqueToken()
+	if err != nil {
+		return "", "", err
+	}
+	key = apiKeyPrefix + token
+	return key, key[:len(apiKeyPrefix)+8], nil
+}
+
+// HTTPAPIKeyToContext moves an api key from the request header to the context
+func HTTPAPIKeyToContext() http.RequestFunc {
+	return func(ctx context.Context, r *stdhttp.Request) context.Context {
+		key := r.Header.Get(APIKeyHeader)
+		if key == "" {
+			return ctx
+		}
+		return context.WithValue(ctx, APIKeyContextKey, key)
+	}
+}
+
+// APIKeyStore is the subset of the backend datastore needed to authenticate api keys
+type APIKeyStore interface {
+	GetAPIKeyByHash(hash string) (error, *database.APIKeyORM)
+	TouchAPIKey(id int32) error
+}
+
+// APIKeyAuthenticator resolves principals from the api key carried by a request context
+type APIKeyAuthenticator struct {
+	store APIKeyStore
+}
+
+// NewAPIKeyAuthenticator returns an authenticator looking api keys up in the provided store
+func NewAPIKeyAuthenticator(store APIKeyStore) APIKeyAuthenticator {
+	return APIKeyAuthenticator{store: store}
+}
+
+// Authenticate implements the Authenticator interface
+func (a APIKeyAuthenticator) Authenticate(ctx context.Context) (Principal, error) {
+	key, ok := ctx.Value(APIKeyContextKey).(string)
+	if !ok {
+		return Principal{}, helper.ErrMissingCredentials
+	}
+
+	err, apiKey := a.store.GetAPIKeyByHash(HashToken(key))
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return Principal{}, helper.ErrInvalidAPIKey
+		}
+		return Principal{}, err
+	}
+
+	if apiKey.RevokedAt != nil {
+		return Principal{}, helper.ErrInvalidAPIKey
+	}
+
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
+		return Principal{}, helper.ErrAPIKeyExpired
+	}
+
+	_ = a.store.TouchAPIKey(apiKey.Id)
+
+	principal := Principal{Scopes: apiKey.Scopes, APIKeyId: apiKey.Id, AccountID: apiKey.AccountID}
+	if apiKey.UserId != nil {
+		principal.UserId = *apiKey.UserId
+	}
+	if apiKey.TeamId != nil {
+		principal.TeamId = *apiKey.TeamId
+	}
+	return principal, nil
+}
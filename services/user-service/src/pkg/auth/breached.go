@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// errMalformedBreachedPasswords is returned when the breached password list does not start with a SHA-1 hash
+var errMalformedBreachedPasswords = errors.New("breached password list must hold one hex encoded SHA-1 hash per line")
+
+// BreachedPasswords is an offline list of passwords known to have leaked in data breaches. The list
+// is read from a local file holding one upper case hex encoded SHA-1 hash per line, optionally
+// followed by a colon and the amount of times it was seen, e.g. the Have I Been Pwned password list.
+// Lines must be ordered by hash. Lookups binary search the file on disk, so that the list, which
+// spans hundreds of millions of hashes, is never held in memory
+type BreachedPasswords struct {
+	file *os.File
+	size int64
+}
+
+// LoadBreachedPasswords opens a breached password list ordered by hash
+func LoadBreachedPasswords(path string) (*BreachedPasswords, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	breached := &BreachedPasswords{file: file, size: info.Size()}
+	first, err := breached.hashAt(0)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if _, err := hex.DecodeString(first); err != nil || len(first) != sha1.Size*2 {
+		file.Close()
+		return nil, errMalformedBreachedPasswords
+	}
+
+	return breached, nil
+}
+
+// Contains reports whether a password appears in the breached password list
+func (b *BreachedPasswords) Contains(password string) (bool, error) {
+	if b == nil {
+		return false, nil
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	// find the smallest offset whose following line holds a hash which does not sort before the
+	// one looked up. The hash is present if and only if that line holds it
+	low, high := int64(0), b.size
+	for low < high {
+		middle := low + (high-low)/2
+		candidate, err := b.hashAt(middle)
+		if err != nil {
+			return false, err
+		}
+
+		if candidate != "" && candidate < hash {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+
+	candidate, err := b.hashAt(low)
+	if err != nil {
+		return false, err
+	}
+	return candidate == hash, nil
+}
+
+// hashAt returns the hash held by the first line starting at or after a given offset, or an empty
+// string if no line starts past it
+func (b *BreachedPasswords) hashAt(offset int64) (string, error) {
+	start := offset
+	if start > 0 {
+		// a line starts at the offset only if the preceding byte ends a line
+		start--
+	}
+
+	reader := bufio.NewReaderSize(io.NewSectionReader(b.file, start, b.size-start), 128)
+	if offset > 0 {
+		if _, err := reader.ReadString('\n'); err == io.EOF {
+			return "", nil
+		} else if err != nil {
+			return "", err
+		}
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	if i := strings.IndexByte(line, ':'); i >= 0 {
+		line = line[:i]
+	}
+	return strings.ToUpper(strings.TrimSpace(line)), nil
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestBreachedPasswordsContains(t *testing.T) {
+	breached := []string{"password", "123456", "qwerty", "letmein", "hunter2", "correct horse"}
+
+	var lines []string
+	for i, password := range breached {
+		sum := sha1.Sum([]byte(password))
+		hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+		if i%2 == 0 {
+			hash += ":42"
+		}
+		lines = append(lines, hash)
+	}
+	sort.Strings(lines)
+
+	dir, err := ioutil.TempDir("", "breached")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "pwned-passwords.txt")
+	if err := ioutil.WriteFile(path, []byte(strings.Join(lines, "\r\n")+"\r\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := LoadBreachedPasswords(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{password: "password", want: true},
+		{password: "123456", want: true},
+		{password: "qwerty", want: true},
+		{password: "letmein", want: true},
+		{password: "hunter2", want: true},
+		{password: "correct horse", want: true},
+		{password: "correct horse battery staple", want: false},
+		{password: "", want: false},
+		{password: "Password", want: false},
+	}
+
+	for _, tt := range tests {
+		got, err := list.Contains(tt.password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Contains(%q) = %v, want %v", tt.password, got, tt.want)
+		}
+	}
+
+	if err := ioutil.WriteFile(path, []byte("not a hash\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadBreachedPasswords(path); err == nil {
+		t.Error("expected a malformed list to be rejected")
+	}
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	// AccessTokenType marks short lived tokens used to access protected resources
+	AccessTokenType = "access"
+
+	// VerificationTokenType marks tokens sent to users in order to verify their email address
+	VerificationTokenType = "email_verification"
+
+	// ChallengeTokenType marks tokens proving a user presented valid credentials and must now
+	// complete two factor authentication
+	ChallengeTokenType = "two_factor_challenge"
+
+	// ErasureReceiptTokenType marks receipts attesting that the personal data of a user was erased
+	ErasureReceiptTokenType = "erasure_receipt"
+)
+
+// Claims witholds the custom claims embedded in every token minted by the user service
+type Claims struct {
+	UserId      int32    `json:"user_id"`
+	TeamId      int32    `json:"team_id,omitempty"`
+	AccountType string   `json:"account_type"`
+	AccountID   string   `json:"account_id"`
+	Scopes      []string `json:"scopes"`
+	TokenType   string   `json:"token_type"`
+	ClientId    string   `json:"client_id,omitempty"`
+	SessionId   string   `json:"sid,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	ActorId     int32    `json:"actor_id,omitempty"`
+	jwt.StandardClaims
+}
+
+// Principal returns the principal identified by a set of claims
+func (c Claims) Principal() Principal {
+	return Principal{
+		UserId:      c.UserId,
+		TeamId:      c.TeamId,
+		AccountType: c.AccountType,
+		AccountID:   c.AccountID,
+		Scopes:      c.Scopes,
+		ClientId:    c.ClientId,
+		SessionId:   c.SessionId,
+		ActorId:     c.ActorId,
+	}
+}
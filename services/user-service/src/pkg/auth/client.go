@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"net"
+	stdhttp "net/http"
+	"strings"
+
+	"github.com/go-kit/kit/transport/http"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+)
+
+// clientContextKey holds the key used to store the client issuing a request in the context
+const clientContextKey contextKey = "client"
+
+// Client describes the user agent a request originates from
+type Client struct {
+	IPAddress string
+	UserAgent string
+}
+
+// HTTPClientToContext moves the address and user agent of the caller into the context. Forwarding
+// headers are only honoured if the service is configured to trust them and the request was received
+// from one of the trusted proxies
+func HTTPClientToContext() http.RequestFunc {
+	var proxies []*net.IPNet
+	if config.Config != nil && config.Config.TrustProxyHeaders {
+		// ranges are validated on start up
+		proxies, _ = ParseTrustedProxies(config.Config.TrustedProxies)
+	}
+
+	return func(ctx context.Context, r *stdhttp.Request) context.Context {
+		return context.WithValue(ctx, clientContextKey, Client{
+			IPAddress: clientIP(r, proxies),
+			UserAgent: r.UserAgent(),
+		})
+	}
+}
+
+// ParseTrustedProxies parses a comma separated list of the CIDR ranges of the reverse proxies whose
+// forwarding headers are trusted
+func ParseTrustedProxies(ranges string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, cidr := range strings.Split(ranges, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+
+		_, proxy, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, proxy)
+	}
+	return proxies, nil
+}
+
+// ClientFromContext obtains the client issuing the request stored in the context if any
+func ClientFromContext(ctx context.Context) Client {
+	client, _ := ctx.Value(clientContextKey).(Client)
+	return client
+}
+
+// clientIP resolves the address of the caller of a request. Requests received from a trusted proxy are
+// attributed to the right-most forwarded address which is not itself a trusted proxy, preceding
+// entries are supplied by the caller and cannot be trusted
+func clientIP(r *stdhttp.Request, proxies []*net.IPNet) string {
+	remote, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remote = r.RemoteAddr
+	}
+
+	if !isTrustedProxy(remote, proxies) {
+		return remote
+	}
+
+	var chain []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		chain = append(chain, strings.Split(header, ",")...)
+	}
+	if len(chain) == 0 {
+		if realIP := strings.TrimSpace(r.Header.Get("X-Real-Ip")); net.ParseIP(realIP) != nil {
+			return realIP
+		}
+		return remote
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		address := strings.TrimSpace(chain[i])
+		if net.ParseIP(address) == nil {
+			// malformed entries can only be attributed to the nearest trusted hop
+			return remote
+		}
+		if !isTrustedProxy(address, proxies) {
+			return address
+		}
+		remote = address
+	}
+
+	return remote
+}
+
+// isTrustedProxy reports whether an address belongs to one of the trusted proxy ranges
+func isTrustedProxy(address string, proxies []*net.IPNet) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
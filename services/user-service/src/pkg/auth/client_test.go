@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"net"
+	stdhttp "net/http"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies("10.0.0.0/8, 192.168.1.1/32")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		remote    string
+		forwarded []string
+		realIP    string
+		proxies   []*net.IPNet
+		want      string
+	}{
+		{name: "direct", remote: "203.0.113.7:4321", want: "203.0.113.7"},
+		{name: "untrusted caller forging headers", remote: "203.0.113.7:4321", forwarded: []string{"198.51.100.1"},
+			realIP: "198.51.100.2", proxies: proxies, want: "203.0.113.7"},
+		{name: "headers ignored without trusted proxies", remote: "10.0.0.1:4321", forwarded: []string{"198.51.100.1"},
+			want: "10.0.0.1"},
+		{name: "trusted proxy", remote: "10.0.0.1:4321", forwarded: []string{"198.51.100.1"}, proxies: proxies,
+			want: "198.51.100.1"},
+		{name: "spoofed entries precede the client", remote: "10.0.0.1:4321",
+			forwarded: []string{"1.2.3.4, 198.51.100.1"}, proxies: proxies, want: "198.51.100.1"},
+		{name: "chained trusted proxies", remote: "10.0.0.1:4321",
+			forwarded: []string{"1.2.3.4, 198.51.100.1", "192.168.1.1, 10.0.0.2"}, proxies: proxies, want: "198.51.100.1"},
+		{name: "malformed entry", remote: "10.0.0.1:4321", forwarded: []string{"198.51.100.1, garbage"},
+			proxies: proxies, want: "10.0.0.1"},
+		{name: "real ip", remote: "10.0.0.1:4321", realIP: "198.51.100.1", proxies: proxies, want: "198.51.100.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &stdhttp.Request{RemoteAddr: tt.remote, Header: stdhttp.Header{}}
+			for _, forwarded := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", forwarded)
+			}
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-Ip", tt.realIP)
+			}
+
+			if got := clientIP(r, tt.proxies); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	if _, err := ParseTrustedProxies("10.0.0.0/8,not-a-range"); err == nil {
+		t.Error("expected malformed ranges to be rejected")
+	}
+
+	proxies, err := ParseTrustedProxies(" ")
+	if err != nil || len(proxies) != 0 {
+		t.Errorf("ParseTrustedProxies() = %v, %v, want no ranges", proxies, err)
+	}
+}
@@ -0,0 +1,4 @@
+/*
+	Package auth witholds token issuance and authentication utilities for the user service
+*/
+package auth
@@ -0,0 +1,334 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+)
+
+const (
+	// rsaKeySize is the size in bits of generated RS256 keys
+	rsaKeySize = 2048
+
+	// keyRefreshInterval is how often a key set reloads its keys from the store. Retired keys are
+	// kept for an additional refresh interval since other instances may still sign with them
+	keyRefreshInterval = 5 * time.Minute
+)
+
+// ErrNoSigningKey is returned when a key set holds no key able to sign tokens
+var ErrNoSigningKey = errors.New("no signing key available")
+
+// SigningKeyStore is the subset of the backend datastore needed to manage token signing keys
+type SigningKeyStore interface {
+	CreateSigningKey(key *database.SigningKeyORM) error
+	GetSigningKeys() (error, []*database.SigningKeyORM)
+	RetireSigningKeys(currentId int32, expiresAt time.Time) error
+	PinSigningKey(keyId string) error
+	DeleteExpiredSigningKeys() error
+}
+
+// signingKey is the parsed form of a stored signing key
+type signingKey struct {
+	id        string
+	method    jwt.SigningMethod
+	private   crypto.Signer
+	public    crypto.PublicKey
+	createdAt time.Time
+	// plaintext reports whether the private key was persisted unencrypted by an earlier release
+	plaintext bool
+}
+
+// KeySet holds the asymmetric keys used to sign and verify tokens. The newest active key signs
+// tokens while retired keys remain available for verification until the tokens they signed expire
+type KeySet struct {
+	store            SigningKeyStore
+	secrets          *Cipher
+	logger           *zap.Logger
+	method           jwt.SigningMethod
+	rotationInterval time.Duration
+	retention        time.Duration
+
+	mu      sync.RWMutex
+	current *signingKey
+	keys    map[string]*signingKey
+}
+
+// NewKeySet returns a key set generating keys with the configured signing algorithm. Private keys are
+// encrypted with the provided cipher prior to being persisted
+func NewKeySet(store SigningKeyStore, secrets *Cipher, logger *zap.Logger, config *config.Configuration) (*KeySet, error) {
+	method := jwt.GetSigningMethod(config.SigningAlgorithm)
+	if method != jwt.SigningMethodRS256 && method != SigningMethodEdDSA {
+		return nil, jwt.ErrInvalidKeyType
+	}
+
+	return &KeySet{
+		store:            store,
+		secrets:          secrets,
+		logger:           logger,
+		method:           method,
+		rotationInterval: config.SigningKeyRotation,
+		retention:        keyRetention(config),
+		keys:             map[string]*signingKey{},
+	}, nil
+}
+
+// keyRetention returns how long retired keys remain available for verification. Keys sign access,
+// email verification, two factor challenge and impersonation tokens alike, hence they are retained
+// until the longest lived of these expires
+func keyRetention(config *config.Configuration) time.Duration {
+	longest := config.AccessTokenExpiry
+	for _, expiry := range []time.Duration{config.VerificationExpiry, config.ChallengeExpiry, config.ImpersonateExpiry} {
+		if expiry > longest {
+			longest = expiry
+		}
+	}
+	return longest + keyRefreshInterval
+}
+
+// Load reloads the key set from the store, generating the first signing key if none exists yet. A
+// signing key persisted in plain text is replaced right away
+func (ks *KeySet) Load() error {
+	err, stored := ks.store.GetSigningKeys()
+	if err != nil {
+		return err
+	}
+
+	var current *signingKey
+	keys := make(map[string]*signingKey, len(stored))
+	for _, k := range stored {
+		key, err := parseSigningKey(k, ks.secrets)
+		if err != nil {
+			ks.logger.Error(err.Error(), zap.String("kid", k.KeyId))
+			continue
+		}
+
+		keys[key.id] = key
+		// keys are ordered newest first
+		if current == nil && k.RetiredAt == nil {
+			current = key
+		}
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.current = current
+	ks.mu.Unlock()
+
+	if current == nil || current.plaintext {
+		return ks.Rotate()
+	}
+
+	return nil
+}
+
+// Rotate generates a new signing key and retires the previous ones. Retired public keys remain
+// published until every token they signed has expired
+func (ks *KeySet) Rotate() error {
+	stored, key, err := ks.generate()
+	if err != nil {
+		ks.logger.Error(err.Error())
+		return err
+	}
+
+	if err := ks.store.CreateSigningKey(stored); err != nil {
+		return err
+	}
+
+	if err := ks.store.RetireSigningKeys(stored.Id, time.Now().Add(ks.retention)); err != nil {
+		return err
+	}
+
+	ks.mu.Lock()
+	ks.keys[key.id] = key
+	ks.current = key
+	ks.mu.Unlock()
+
+	ks.logger.Info("Signing key rotated", zap.String("kid", key.id), zap.String("alg", key.method.Alg()))
+	return nil
+}
+
+// Run periodically reloads the key set, rotates the signing key once it reaches the configured
+// age and purges expired keys. It blocks until done is closed
+func (ks *KeySet) Run(done <-chan struct{}) {
+	ticker := time.NewTicker(keyRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ks.maintain()
+		case <-done:
+			return
+		}
+	}
+}
+
+// maintain performs a single round of key set maintenance
+func (ks *KeySet) maintain() {
+	if err := ks.Load(); err != nil {
+		ks.logger.Error(err.Error())
+		return
+	}
+
+	ks.mu.RLock()
+	current := ks.current
+	ks.mu.RUnlock()
+
+	if current != nil && time.Since(current.createdAt) >= ks.rotationInterval {
+		if err := ks.Rotate(); err != nil {
+			return
+		}
+	}
+
+	_ = ks.store.DeleteExpiredSigningKeys()
+}
+
+// Algorithm returns the jwt algorithm used to sign tokens
+func (ks *KeySet) Algorithm() string {
+	return ks.method.Alg()
+}
+
+// signingKey returns the key tokens should currently be signed with
+func (ks *KeySet) signingKey() (*signingKey, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if ks.current == nil {
+		return nil, ErrNoSigningKey
+	}
+	return ks.current, nil
+}
+
+// pin keeps a key published indefinitely, including once retired
+func (ks *KeySet) pin(key *signingKey) error {
+	return ks.store.PinSigningKey(key.id)
+}
+
+// verificationKey returns the key identified by a token's kid header
+func (ks *KeySet) verificationKey(kid string) (*signingKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// JSONWebKeySet returns the public keys of the key set
+func (ks *KeySet) JSONWebKeySet() JSONWebKeySet {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		set.Keys = append(set.Keys, newJSONWebKey(key))
+	}
+	return set
+}
+
+// generate creates a new key pair using the algorithm of the key set
+func (ks *KeySet) generate() (*database.SigningKeyORM, *signingKey, error) {
+	var (
+		private crypto.Signer
+		err     error
+	)
+
+	switch ks.method {
+	case SigningMethodEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(private.Public())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// private keys are encrypted at rest so that reading the database does not suffice to mint tokens
+	encrypted, err := ks.secrets.Encrypt(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored := &database.SigningKeyORM{
+		KeyId:      NewTokenId(),
+		Algorithm:  ks.method.Alg(),
+		PrivateKey: encrypted,
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+	}
+
+	return stored, &signingKey{
+		id:        stored.KeyId,
+		method:    ks.method,
+		private:   private,
+		public:    private.Public(),
+		createdAt: time.Now(),
+	}, nil
+}
+
+// parseSigningKey decrypts and decodes a stored signing key. Keys persisted in plain text by earlier
+// releases are decoded as is and flagged as such
+func parseSigningKey(stored *database.SigningKeyORM, secrets *Cipher) (*signingKey, error) {
+	method := jwt.GetSigningMethod(stored.Algorithm)
+	if method == nil {
+		return nil, jwt.ErrInvalidKeyType
+	}
+
+	privatePEM := stored.PrivateKey
+	plaintext := strings.HasPrefix(privatePEM, "-----BEGIN")
+	if !plaintext {
+		decrypted, err := secrets.Decrypt(privatePEM)
+		if err != nil {
+			return nil, err
+		}
+		privatePEM = decrypted
+	}
+
+	block, _ := pem.Decode([]byte(privatePEM))
+	if block == nil {
+		return nil, jwt.ErrKeyMustBePEMEncoded
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	private, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, jwt.ErrInvalidKeyType
+	}
+
+	key := &signingKey{
+		id:        stored.KeyId,
+		method:    method,
+		private:   private,
+		public:    private.Public(),
+		plaintext: plaintext,
+	}
+	if stored.CreatedAt != nil {
+		key.createdAt = *stored.CreatedAt
+	}
+	return key, nil
+}
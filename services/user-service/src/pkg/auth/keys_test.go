@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+)
+
+func TestSigningKeysEncryptedAtRest(t *testing.T) {
+	secrets, err := NewCipher("test-encryption-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ks, err := NewKeySet(nil, secrets, zap.NewNop(), &config.Configuration{
+		ServerConfiguration: config.ServerConfiguration{SigningAlgorithm: SigningMethodEdDSA.Alg()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, generated, err := ks.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(stored.PrivateKey, "PRIVATE KEY") {
+		t.Fatal("expected the private key to be encrypted")
+	}
+
+	parsed, err := parseSigningKey(stored, secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.plaintext || parsed.id != generated.id {
+		t.Errorf("parseSigningKey() = %+v, want the generated encrypted key", parsed)
+	}
+
+	other, _ := NewCipher("another-encryption-key")
+	if _, err := parseSigningKey(stored, other); err == nil {
+		t.Error("expected decryption under another key to fail")
+	}
+
+	// keys persisted by earlier releases are decoded and flagged for replacement
+	legacy := *stored
+	legacy.PrivateKey, _ = secrets.Decrypt(stored.PrivateKey)
+	parsed, err = parseSigningKey(&legacy, secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.plaintext {
+		t.Error("expected the plain text key to be flagged")
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+const (
+	// AuthorizationCodeGrantType exchanges an authorization code for a token pair
+	AuthorizationCodeGrantType = "authorization_code"
+	// RefreshTokenGrantType exchanges a refresh token for a new token pair
+	RefreshTokenGrantType = "refresh_token"
+
+	// CodeResponseType requests an authorization code from the authorization endpoint
+	CodeResponseType = "code"
+
+	// CodeChallengeMethodS256 is the only PKCE code challenge method supported (RFC 7636)
+	CodeChallengeMethodS256 = "S256"
+)
+
+// AuthorizationRequest witholds the parameters of an oauth2 authorization request
+type AuthorizationRequest struct {
+	ResponseType        string
+	ClientId            string
+	RedirectURI         string
+	Scopes              []string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// TokenRequest witholds the parameters of an oauth2 token request
+type TokenRequest struct {
+	GrantType    string
+	ClientId     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+}
+
+// VerifyCodeChallenge checks that a PKCE code verifier matches the S256 challenge it was derived from
+func VerifyCodeChallenge(challenge string, verifier string) bool {
+	if challenge == "" || verifier == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
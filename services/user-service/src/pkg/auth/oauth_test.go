@@ -0,0 +1,34 @@
+package auth
+
+import "testing"
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	// the verifier and S256 challenge of RFC 7636 appendix B
+	const (
+		verifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+		challenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	)
+
+	tests := []struct {
+		name      string
+		challenge string
+		verifier  string
+		want      bool
+	}{
+		{name: "rfc 7636 appendix b", challenge: challenge, verifier: verifier, want: true},
+		{name: "wrong verifier", challenge: challenge, verifier: verifier[1:], want: false},
+		{name: "plain challenge", challenge: verifier, verifier: verifier, want: false},
+		{name: "padded challenge", challenge: challenge + "=", verifier: verifier, want: false},
+		{name: "standard base64 challenge", challenge: "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw+cM", verifier: verifier, want: false},
+		{name: "missing challenge", challenge: "", verifier: verifier, want: false},
+		{name: "missing verifier", challenge: challenge, verifier: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyCodeChallenge(tt.challenge, tt.verifier); got != tt.want {
+				t.Errorf("VerifyCodeChallenge(%q, %q) = %v, want %v", tt.challenge, tt.verifier, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+)
+
+// Password hashing algorithms supported by the user service
+const (
+	BcryptAlgorithm   = "bcrypt"
+	Argon2idAlgorithm = "argon2id"
+)
+
+const (
+	argon2idPrefix     = "$argon2id$"
+	argon2idSaltLength = 16
+	argon2idKeyLength  = 32
+)
+
+var (
+	// errUnsupportedHashAlgorithm is returned when the configured password hashing algorithm is unknown
+	errUnsupportedHashAlgorithm = errors.New("unsupported password hashing algorithm")
+	// errInvalidHashParameters is returned when the configured argon2id parameters are unusable
+	errInvalidHashParameters = errors.New("invalid password hashing parameters")
+	// errMalformedPasswordHash is returned when a stored password hash can not be decoded
+	errMalformedPasswordHash = errors.New("malformed password hash")
+)
+
+// PasswordHasher hashes passwords prior to persisting them and verifies passwords against stored
+// hashes. Hashes encode their algorithm and parameters so that hashes produced under a previous
+// configuration remain verifiable
+type PasswordHasher interface {
+	// Hash hashes and salts a password with the configured algorithm and parameters
+	Hash(password string) (string, error)
+	// Verify reports whether a password matches a stored hash, whatever algorithm produced it
+	Verify(hash string, password string) (bool, error)
+	// NeedsRehash reports whether a stored hash was produced with another algorithm or weaker
+	// parameters than the configured ones
+	NeedsRehash(hash string) bool
+}
+
+// NewPasswordHasher returns the password hasher selected by the configuration
+func NewPasswordHasher(config *config.Configuration) (PasswordHasher, error) {
+	switch config.PasswordHashing {
+	case BcryptAlgorithm:
+		if config.BcryptCost < bcrypt.MinCost || config.BcryptCost > bcrypt.MaxCost {
+			return nil, bcrypt.InvalidCostError(config.BcryptCost)
+		}
+		return bcryptHasher{cost: config.BcryptCost}, nil
+	case Argon2idAlgorithm:
+		params := argon2idParams{
+			memory:  config.Argon2Memory,
+			time:    config.Argon2Time,
+			threads: config.Argon2Threads,
+		}
+		if params.memory < 8*uint32(params.threads) || params.time == 0 || params.threads == 0 {
+			return nil, errInvalidHashParameters
+		}
+		return argon2idHasher{params: params}, nil
+	default:
+		return nil, errUnsupportedHashAlgorithm
+	}
+}
+
+// verifyPassword checks a password against a hash produced by any supported algorithm
+func verifyPassword(hash string, password string) (bool, error) {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		params, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return false, err
+		}
+		derived := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+		return subtle.ConstantTimeCompare(derived, key) == 1, nil
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// bcryptHasher hashes passwords with bcrypt at a given cost
+type bcryptHasher struct {
+	cost int
+}
+
+func (h bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h bcryptHasher) Verify(hash string, password string) (bool, error) {
+	return verifyPassword(hash, password)
+}
+
+func (h bcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost < h.cost
+}
+
+// argon2idParams witholds the cost parameters of argon2id. Memory is expressed in KiB
+type argon2idParams struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+// argon2idHasher hashes passwords with argon2id. Hashes are encoded in the PHC string format,
+// i.e. $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>
+type argon2idHasher struct {
+	params argon2idParams
+}
+
+func (h argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2idSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.time, h.params.memory, h.params.threads, argon2idKeyLength)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version,
+		h.params.memory, h.params.time, h.params.threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (h argon2idHasher) Verify(hash string, password string) (bool, error) {
+	return verifyPassword(hash, password)
+}
+
+func (h argon2idHasher) NeedsRehash(hash string) bool {
+	params, _, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.memory < h.params.memory || params.time < h.params.time ||
+		params.threads < h.params.threads || len(key) < argon2idKeyLength
+}
+
+// decodeArgon2id obtains the parameters, salt and derived key from an encoded argon2id hash
+func decodeArgon2id(hash string) (params argon2idParams, salt []byte, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != Argon2idAlgorithm {
+		return params, nil, nil, errMalformedPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, errMalformedPasswordHash
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return params, nil, nil, errMalformedPasswordHash
+	}
+
+	if params.time == 0 || params.threads == 0 {
+		return params, nil, nil, errMalformedPasswordHash
+	}
+
+	salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, errMalformedPasswordHash
+	}
+
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, errMalformedPasswordHash
+	}
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+)
+
+// encodeArgon2id encodes an argon2id hash with given parameters and a key of a given length
+func encodeArgon2id(version, memory, time, threads, keyLength int) string {
+	salt := base64.RawStdEncoding.EncodeToString([]byte("saltsaltsaltsalt"))
+	key := base64.RawStdEncoding.EncodeToString(make([]byte, keyLength))
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", version, memory, time, threads, salt, key)
+}
+
+func TestPasswordHasherRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config.ServerConfiguration
+		prefix string
+	}{
+		{name: "bcrypt", config: config.ServerConfiguration{PasswordHashing: BcryptAlgorithm, BcryptCost: bcrypt.MinCost},
+			prefix: "$2a$"},
+		{name: "argon2id", config: config.ServerConfiguration{PasswordHashing: Argon2idAlgorithm, Argon2Memory: 64,
+			Argon2Time: 1, Argon2Threads: 1}, prefix: "$argon2id$v=19$m=64,t=1,p=1$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasher, err := NewPasswordHasher(&config.Configuration{ServerConfiguration: tt.config})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hash, err := hasher.Hash("correct horse battery staple")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(hash, tt.prefix) {
+				t.Errorf("Hash() = %q, want prefix %q", hash, tt.prefix)
+			}
+
+			if ok, err := hasher.Verify(hash, "correct horse battery staple"); err != nil || !ok {
+				t.Errorf("Verify() of the hashed password = %v, %v, want true", ok, err)
+			}
+			if ok, err := hasher.Verify(hash, "correct horse battery stable"); err != nil || ok {
+				t.Errorf("Verify() of another password = %v, %v, want false", ok, err)
+			}
+			if hasher.NeedsRehash(hash) {
+				t.Error("NeedsRehash() of a hash produced under the configured parameters = true, want false")
+			}
+		})
+	}
+}
+
+func TestNewPasswordHasherRejectsInvalidConfigurations(t *testing.T) {
+	configs := []config.ServerConfiguration{
+		{PasswordHashing: "scrypt"},
+		{PasswordHashing: BcryptAlgorithm, BcryptCost: bcrypt.MinCost - 1},
+		{PasswordHashing: BcryptAlgorithm, BcryptCost: bcrypt.MaxCost + 1},
+		{PasswordHashing: Argon2idAlgorithm, Argon2Memory: 64, Argon2Time: 0, Argon2Threads: 1},
+		{PasswordHashing: Argon2idAlgorithm, Argon2Memory: 64, Argon2Time: 1, Argon2Threads: 0},
+		{PasswordHashing: Argon2idAlgorithm, Argon2Memory: 8, Argon2Time: 1, Argon2Threads: 2},
+	}
+
+	for _, c := range configs {
+		if _, err := NewPasswordHasher(&config.Configuration{ServerConfiguration: c}); err == nil {
+			t.Errorf("NewPasswordHasher(%+v) succeeded, want an error", c)
+		}
+	}
+}
+
+func TestDecodeArgon2id(t *testing.T) {
+	valid := encodeArgon2id(19, 65536, 3, 4, 32)
+
+	params, salt, key, err := decodeArgon2id(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (argon2idParams{memory: 65536, time: 3, threads: 4}); params != want {
+		t.Errorf("decodeArgon2id() params = %+v, want %+v", params, want)
+	}
+	if string(salt) != "saltsaltsaltsalt" || len(key) != 32 {
+		t.Errorf("decodeArgon2id() salt = %q, key of %d bytes", salt, len(key))
+	}
+
+	malformed := []struct {
+		name string
+		hash string
+	}{
+		{name: "empty", hash: ""},
+		{name: "bcrypt", hash: "$2a$10$abcdefghijklmnopqrstuuABCDEFGHIJKLMNOPQRSTUVWXYZ01234"},
+		{name: "argon2i", hash: strings.Replace(valid, "argon2id", "argon2i", 1)},
+		{name: "missing key", hash: valid[:strings.LastIndexByte(valid, '$')]},
+		{name: "extra segment", hash: valid + "$AAAA"},
+		{name: "unsupported version", hash: encodeArgon2id(16, 65536, 3, 4, 32)},
+		{name: "missing version", hash: strings.Replace(valid, "v=19", "19", 1)},
+		{name: "missing parameters", hash: strings.Replace(valid, "m=65536,t=3,p=4", "m=65536", 1)},
+		{name: "zero time", hash: encodeArgon2id(19, 65536, 0, 4, 32)},
+		{name: "zero threads", hash: encodeArgon2id(19, 65536, 3, 0, 32)},
+		{name: "malformed salt", hash: strings.Replace(valid, "c2FsdHNhbHRzYWx0c2FsdA", "c2Fsd!", 1)},
+		{name: "empty key", hash: encodeArgon2id(19, 65536, 3, 4, 0)},
+	}
+
+	for _, tt := range malformed {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := decodeArgon2id(tt.hash); err != errMalformedPasswordHash {
+				t.Errorf("decodeArgon2id(%q) error = %v, want %v", tt.hash, err, errMalformedPasswordHash)
+			}
+			if !strings.HasPrefix(tt.hash, argon2idPrefix) {
+				return
+			}
+			if ok, err := verifyPassword(tt.hash, "password"); err == nil || ok {
+				t.Errorf("verifyPassword(%q) = %v, %v, want an error", tt.hash, ok, err)
+			}
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	bcryptHash := func(cost int) string {
+		hash, err := bcrypt.GenerateFromPassword([]byte("password"), cost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(hash)
+	}
+
+	argon := argon2idHasher{params: argon2idParams{memory: 65536, time: 3, threads: 4}}
+	bcrypter := bcryptHasher{cost: bcrypt.MinCost + 1}
+
+	tests := []struct {
+		name   string
+		hasher PasswordHasher
+		hash   string
+		want   bool
+	}{
+		{name: "argon2id configured parameters", hasher: argon, hash: encodeArgon2id(19, 65536, 3, 4, 32), want: false},
+		{name: "argon2id stronger parameters", hasher: argon, hash: encodeArgon2id(19, 131072, 4, 8, 64), want: false},
+		{name: "argon2id less memory", hasher: argon, hash: encodeArgon2id(19, 32768, 3, 4, 32), want: true},
+		{name: "argon2id fewer iterations", hasher: argon, hash: encodeArgon2id(19, 65536, 2, 4, 32), want: true},
+		{name: "argon2id fewer threads", hasher: argon, hash: encodeArgon2id(19, 65536, 3, 2, 32), want: true},
+		{name: "argon2id shorter key", hasher: argon, hash: encodeArgon2id(19, 65536, 3, 4, 16), want: true},
+		{name: "argon2id malformed", hasher: argon, hash: "$argon2id$v=19$m=65536", want: true},
+		{name: "argon2id from bcrypt", hasher: argon, hash: bcryptHash(bcrypt.MinCost), want: true},
+		{name: "bcrypt configured cost", hasher: bcrypter, hash: bcryptHash(bcrypt.MinCost + 1), want: false},
+		{name: "bcrypt higher cost", hasher: bcrypter, hash: bcryptHash(bcrypt.MinCost + 2), want: false},
+		{name: "bcrypt lower cost", hasher: bcrypter, hash: bcryptHash(bcrypt.MinCost), want: true},
+		{name: "bcrypt malformed", hasher: bcrypter, hash: "$2a$", want: true},
+		{name: "bcrypt from argon2id", hasher: bcrypter, hash: encodeArgon2id(19, 65536, 3, 4, 32), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash(%q) = %v, want %v", tt.hash, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyPasswordAcrossAlgorithms(t *testing.T) {
+	previous := bcryptHasher{cost: bcrypt.MinCost}
+	hash, err := previous.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// hashes produced before switching to argon2id remain verifiable
+	current := argon2idHasher{params: argon2idParams{memory: 64, time: 1, threads: 1}}
+	if ok, err := current.Verify(hash, "password"); err != nil || !ok {
+		t.Errorf("Verify() of a bcrypt hash = %v, %v, want true", ok, err)
+	}
+	if !current.NeedsRehash(hash) {
+		t.Error("NeedsRehash() of a bcrypt hash = false, want true")
+	}
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// Rules of the password policy reported to clients when violated
+const (
+	RuleMinLength        = "min_length"
+	RuleCharacterClasses = "character_classes"
+	RuleContainsUsername = "contains_username"
+	RuleContainsEmail    = "contains_email"
+	RulePasswordReused   = "password_reused"
+	RuleBreachedPassword = "breached_password"
+)
+
+// minIdentifierLength is the length below which usernames and emails are too short to be
+// meaningfully searched for in a password
+const minIdentifierLength = 3
+
+// PasswordPolicy checks candidate passwords against the configured rules
+type PasswordPolicy struct {
+	minLength int
+	classes   int
+	history   int
+	breached  *BreachedPasswords
+}
+
+// PasswordCandidate witholds a password being set alongside the identity of the user setting it.
+// PreviousHashes holds the hashes of the passwords the user set most recently
+type PasswordCandidate struct {
+	Password       string
+	Username       string
+	Email          string
+	PreviousHashes []string
+}
+
+// NewPasswordPolicy returns the password policy described by the configuration. The breached
+// password list is only opened if a file is configured
+func NewPasswordPolicy(config *config.Configuration) (*PasswordPolicy, error) {
+	policy := &PasswordPolicy{
+		minLength: config.PasswordMinLength,
+		classes:   config.PasswordClasses,
+		history:   config.PasswordHistory,
+	}
+
+	if config.BreachedPasswords != "" {
+		breached, err := LoadBreachedPasswords(config.BreachedPasswords)
+		if err != nil {
+			return nil, err
+		}
+		policy.breached = breached
+	}
+
+	return policy, nil
+}
+
+// History returns the amount of most recent passwords of a user which may not be reused
+func (p *PasswordPolicy) History() int {
+	return p.history
+}
+
+// Check verifies a candidate password against every rule of the policy. A *helper.PasswordPolicyError
+// listing all violated rules is returned if the password is rejected
+func (p *PasswordPolicy) Check(candidate PasswordCandidate, hasher PasswordHasher) error {
+	var violations []helper.PasswordPolicyViolation
+	violate := func(rule string, message string) {
+		violations = append(violations, helper.PasswordPolicyViolation{Rule: rule, Message: message})
+	}
+
+	password := candidate.Password
+	if len([]rune(password)) < p.minLength {
+		violate(RuleMinLength, fmt.Sprintf("password must be at least %d characters long", p.minLength))
+	}
+
+	if p.classes > 0 && characterClasses(password) < p.classes {
+		violate(RuleCharacterClasses, fmt.Sprintf("password must contain at least %d of lower case letters, "+
+			"upper case letters, digits and symbols", p.classes))
+	}
+
+	lowered := strings.ToLower(password)
+	username := strings.ToLower(candidate.Username)
+	if len(username) >= minIdentifierLength && strings.Contains(lowered, username) {
+		violate(RuleContainsUsername, "password must not contain the username")
+	}
+
+	email := strings.ToLower(candidate.Email)
+	localPart := email
+	if i := strings.LastIndexByte(email, '@'); i >= 0 {
+		localPart = email[:i]
+	}
+	if len(localPart) >= minIdentifierLength && strings.Contains(lowered, localPart) {
+		violate(RuleContainsEmail, "password must not contain the email address")
+	}
+
+	breached, err := p.breached.Contains(password)
+	if err != nil {
+		return err
+	}
+	if breached {
+		violate(RuleBreachedPassword, "password appeared in a data breach and must not be used")
+	}
+
+	for _, hash := range candidate.PreviousHashes {
+		if reused, err := hasher.Verify(hash, password); err == nil && reused {
+			violate(RulePasswordReused, fmt.Sprintf("password must differ from the last %d passwords", p.history))
+			break
+		}
+	}
+
+	if len(violations) > 0 {
+		return &helper.PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// characterClasses counts the classes of characters, amongst lower case letters, upper case
+// letters, digits and symbols, a password is made of
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			classes++
+		}
+	}
+	return classes
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+type contextKey string
+
+// principalContextKey holds the key used to store the authenticated principal in the context
+const principalContextKey contextKey = "principal"
+
+// Principal witholds the identity on whose behalf a request is performed
+type Principal struct {
+	UserId      int32
+	TeamId      int32
+	AccountType string
+	AccountID   string
+	Scopes      []string
+	// APIKeyId is set when the principal authenticated with an api key
+	APIKeyId int32
+	// ClientId is set when the principal is an oauth client acting on behalf of a user
+	ClientId string
+	// SessionId is set when the principal authenticated with an access token issued for a session
+	SessionId string
+	// ActorId is set to the id of the staff member acting on behalf of the user when the principal
+	// authenticated with an impersonation token
+	ActorId int32
+}
+
+// IsFirstParty reports whether the principal is a user authenticated with a token issued
+// directly by the user service rather than through an api key or an oauth client
+func (p Principal) IsFirstParty() bool {
+	return p.UserId != 0 && p.APIKeyId == 0 && p.ClientId == ""
+}
+
+// IsTeam reports whether the principal is a team authenticated with a token issued to the team
+// itself rather than to one of its members
+func (p Principal) IsTeam() bool {
+	return p.UserId == 0 && p.TeamId != 0 && p.APIKeyId == 0 && p.ClientId == ""
+}
+
+// IsImpersonated reports whether the principal is a user impersonated by a staff member
+func (p Principal) IsImpersonated() bool {
+	return p.ActorId != 0
+}
+
+// HasScope reports whether the principal was granted a given scope. The write scope
+// implies the read scope
+func (p Principal) HasScope(scope string) bool {
+	for _, granted := range p.Scopes {
+		if granted == scope || (granted == ScopeWrite && scope == ScopeRead) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewContext returns a copy of the context carrying the provided principal. The context is
+// scoped to the account of the principal if known
+func NewContext(ctx context.Context, principal Principal) context.Context {
+	if principal.AccountID != "" {
+		ctx = NewAccountContext(ctx, principal.AccountID)
+	}
+	return context.WithValue(ctx, principalContextKey, principal)
+}
+
+// FromContext obtains the authenticated principal stored in the context if any
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey).(Principal)
+	return principal, ok
+}
+
+// Authenticator resolves the principal behind the credentials carried by a request context
+type Authenticator interface {
+	Authenticate(ctx context.Context) (Principal, error)
+}
+
+// Chain returns an authenticator trying each of the provided authenticators in turn until
+// one of them finds credentials in the request context
+func Chain(authenticators ...Authenticator) Authenticator {
+	return chain(authenticators)
+}
+
+type chain []Authenticator
+
+// Authenticate implements the Authenticator interface
+func (c chain) Authenticate(ctx context.Context) (Principal, error) {
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(ctx)
+		if err == helper.ErrMissingCredentials {
+			continue
+		}
+		return principal, err
+	}
+	return Principal{}, helper.ErrUnauthorized
+}
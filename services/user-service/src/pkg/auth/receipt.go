@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"strconv"
+
+	"github.com/dgrijalva/jwt-go"
+
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// ErasureClaims witholds the claims of a signed erasure receipt
+type ErasureClaims struct {
+	UserId        int32                           `json:"user_id"`
+	AccountID     string                          `json:"account_id"`
+	ActorId       int32                           `json:"actor_id,omitempty"`
+	TokenType     string                          `json:"token_type"`
+	Tables        database.ErasedTables           `json:"tables"`
+	Reassignments database.OwnershipReassignments `json:"reassignments"`
+	jwt.StandardClaims
+}
+
+// SealErasureReceipt identifies an erasure receipt and signs its content. Receipts never expire, hence the
+// key signing them is pinned so that it remains published in the json web key set once retired
+func (tm *TokenManager) SealErasureReceipt(receipt *database.ErasureReceiptORM) error {
+	key, err := tm.keys.signingKey()
+	if err != nil {
+		return err
+	}
+
+	if err := tm.keys.pin(key); err != nil {
+		return err
+	}
+
+	receipt.ReceiptId = NewTokenId()
+	signed, err := signWith(key, ErasureClaims{
+		UserId:        receipt.UserId,
+		AccountID:     receipt.AccountID,
+		ActorId:       receipt.ActorId,
+		TokenType:     ErasureReceiptTokenType,
+		Tables:        receipt.Tables,
+		Reassignments: receipt.Reassignments,
+		StandardClaims: jwt.StandardClaims{
+			Id:       receipt.ReceiptId,
+			Subject:  strconv.Itoa(int(receipt.UserId)),
+			Issuer:   tm.issuer,
+			IssuedAt: receipt.ErasedAt.Unix(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	receipt.Receipt = signed
+	return nil
+}
+
+// ParseErasureReceipt verifies the signature of an erasure receipt against the published signing keys
+// and returns its claims
+func (tm *TokenManager) ParseErasureReceipt(receipt string) (*ErasureClaims, error) {
+	claims := &ErasureClaims{}
+	token, err := jwt.ParseWithClaims(receipt, claims, tm.verificationKeyFunc)
+	if err != nil {
+		return nil, helper.ErrInvalidErasureReceipt
+	}
+
+	if !token.Valid || claims.Issuer != tm.issuer || claims.TokenType != ErasureReceiptTokenType || claims.Id == "" {
+		return nil, helper.ErrInvalidErasureReceipt
+	}
+	return claims, nil
+}
+
+// VerifyErasureReceipt checks that a signed erasure receipt is the one issued and stored by the service
+func VerifyErasureReceipt(receipt string, issued database.ErasureReceiptORM) error {
+	if subtle.ConstantTimeCompare([]byte(receipt), []byte(issued.Receipt)) != 1 {
+		return helper.ErrInvalidErasureReceipt
+	}
+	return nil
+}
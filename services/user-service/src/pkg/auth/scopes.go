@@ -0,0 +1,32 @@
+package auth
+
+const (
+	// ScopeRead grants read-only access to the user service api
+	ScopeRead = "api:read"
+	// ScopeWrite grants read and write access to the user service api
+	ScopeWrite = "api:write"
+	// ScopeAdmin grants access to the administrative operations of the user service api. It is
+	// never delegated to api keys or oauth clients
+	ScopeAdmin = "api:admin"
+)
+
+// AdminAccountType is the account type of platform staff. Accounts of this type can only be
+// provisioned directly in the backend datastore
+const AdminAccountType = "Admin"
+
+// TeamAccountType is the account type carried by tokens issued to teams logging in with their
+// own credentials
+const TeamAccountType = "Team"
+
+// ScopesForAccountType returns the set of scopes granted to a given account type
+func ScopesForAccountType(accountType string) []string {
+	if accountType == AdminAccountType {
+		return []string{ScopeRead, ScopeWrite, ScopeAdmin}
+	}
+	return []string{ScopeRead, ScopeWrite}
+}
+
+// IsKnownScope reports whether a scope is defined by the user service
+func IsKnownScope(scope string) bool {
+	return scope == ScopeRead || scope == ScopeWrite
+}
@@ -0,0 +1,306 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	kitjwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/jinzhu/gorm"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// BearerTokenType is the token type reported to clients alongside issued tokens
+const BearerTokenType = "Bearer"
+
+// teamSubjectPrefix distinguishes the subject of tokens issued to teams from the ones issued to users
+const teamSubjectPrefix = "team:"
+
+// TokenPair witholds the access and refresh tokens issued to a principal
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// SessionStore is the subset of the backend datastore needed to check that the session an access
+// token was issued for was not revoked
+type SessionStore interface {
+	IsSessionActive(familyId string) (bool, error)
+}
+
+// TokenManager mints signed jwt tokens on behalf of the user service
+type TokenManager struct {
+	keys                    *KeySet
+	sessions                SessionStore
+	issuer                  string
+	publicUrl               string
+	accessTokenExpiry       time.Duration
+	refreshTokenExpiry      time.Duration
+	verificationTokenExpiry time.Duration
+	challengeTokenExpiry    time.Duration
+	impersonationExpiry     time.Duration
+}
+
+// NewTokenManager returns a token manager signing tokens with the keys of the provided key set and
+// rejecting access tokens whose session was revoked
+func NewTokenManager(config *config.Configuration, keys *KeySet, sessions SessionStore) *TokenManager {
+	return &TokenManager{
+		keys:                    keys,
+		sessions:                sessions,
+		issuer:                  config.Issuer,
+		publicUrl:               strings.TrimSuffix(config.PublicUrl, "/"),
+		accessTokenExpiry:       config.AccessTokenExpiry,
+		refreshTokenExpiry:      config.RefreshTokenExpiry,
+		verificationTokenExpiry: config.VerificationExpiry,
+		challengeTokenExpiry:    config.ChallengeExpiry,
+		impersonationExpiry:     config.ImpersonateExpiry,
+	}
+}
+
+// IssueAccessToken mints a short lived access token granting a set of scopes for a given user within
+// a given session. Tokens minted on behalf of oauth clients carry the id of the client
+func (tm *TokenManager) IssueAccessToken(user user_service.UserORM, scopes []string, clientId string, sessionId string) (string, error) {
+	claims := tm.newClaims(user, scopes, AccessTokenType, time.Now(), tm.accessTokenExpiry)
+	claims.ClientId = clientId
+	claims.SessionId = sessionId
+	return tm.sign(claims)
+}
+
+// IssueTeamAccessToken mints a short lived access token granting a set of scopes to a team within a
+// given session. The token identifies the team alone, never one of its members
+func (tm *TokenManager) IssueTeamAccessToken(team user_service.TeamORM, scopes []string, sessionId string) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		TeamId:      team.Id,
+		AccountType: TeamAccountType,
+		Scopes:      scopes,
+		TokenType:   AccessTokenType,
+		SessionId:   sessionId,
+		StandardClaims: jwt.StandardClaims{
+			Id:        NewTokenId(),
+			Subject:   teamSubjectPrefix + strconv.Itoa(int(team.Id)),
+			Issuer:    tm.issuer,
+			IssuedAt:  now.Unix(),
+			NotBefore: now.Unix(),
+			ExpiresAt: now.Add(tm.accessTokenExpiry).Unix(),
+		},
+	}
+	return tm.sign(claims)
+}
+
+// IssueImpersonationToken mints an access token letting a staff member act on behalf of a given user.
+// The token identifies both the user and the staff member, is never accompanied by a refresh token and
+// expires once the impersonation expiry elapses
+func (tm *TokenManager) IssueImpersonationToken(user user_service.UserORM, actorId int32) (TokenPair, error) {
+	claims := tm.newClaims(user, ScopesForAccountType(user.UserAccountType), AccessTokenType, time.Now(), tm.impersonationExpiry)
+	claims.ActorId = actorId
+
+	accessToken, err := tm.sign(claims)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	return TokenPair{
+		AccessToken: accessToken,
+		TokenType:   BearerTokenType,
+		ExpiresIn:   int64(tm.impersonationExpiry.Seconds()),
+	}, nil
+}
+
+// NewTokenPair bundles an access token and its companion refresh token
+func (tm *TokenManager) NewTokenPair(accessToken, refreshToken string) TokenPair {
+	return TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    BearerTokenType,
+		ExpiresIn:    int64(tm.accessTokenExpiry.Seconds()),
+	}
+}
+
+// RefreshTokenExpiry returns the lifetime of issued refresh tokens
+func (tm *TokenManager) RefreshTokenExpiry() time.Duration {
+	return tm.refreshTokenExpiry
+}
+
+// ParseAccessToken verifies the signature, issuer and lifetime of an access token and
+// returns its claims
+func (tm *TokenManager) ParseAccessToken(tokenString string) (*Claims, error) {
+	claims, err := tm.parse(tokenString, AccessTokenType)
+	if err != nil {
+		if isExpired(err) {
+			return nil, helper.ErrAccessTokenExpired
+		}
+		return nil, helper.ErrUnauthorized
+	}
+
+	return claims, nil
+}
+
+// IssueVerificationToken mints a token verifying that a user owns their current email address
+func (tm *TokenManager) IssueVerificationToken(user user_service.UserORM) (string, error) {
+	claims := tm.newClaims(user, nil, VerificationTokenType, time.Now(), tm.verificationTokenExpiry)
+	claims.Email = user.Email
+	return tm.sign(claims)
+}
+
+// ParseVerificationToken verifies an email verification token and returns its claims
+func (tm *TokenManager) ParseVerificationToken(tokenString string) (*Claims, error) {
+	claims, err := tm.parse(tokenString, VerificationTokenType)
+	if err != nil {
+		if isExpired(err) {
+			return nil, helper.ErrVerificationTokenExpired
+		}
+		return nil, helper.ErrInvalidVerificationToken
+	}
+
+	return claims, nil
+}
+
+// IssueChallengeToken mints a short lived token a user exchanges, alongside a two factor
+// authentication code, for a token pair
+func (tm *TokenManager) IssueChallengeToken(user user_service.UserORM) (string, error) {
+	claims := tm.newClaims(user, nil, ChallengeTokenType, time.Now(), tm.challengeTokenExpiry)
+	return tm.sign(claims)
+}
+
+// ParseChallengeToken verifies a two factor challenge token and returns its claims
+func (tm *TokenManager) ParseChallengeToken(tokenString string) (*Claims, error) {
+	claims, err := tm.parse(tokenString, ChallengeTokenType)
+	if err != nil {
+		if isExpired(err) {
+			return nil, helper.ErrChallengeTokenExpired
+		}
+		return nil, helper.ErrInvalidChallengeToken
+	}
+
+	return claims, nil
+}
+
+// Authenticate resolves the principal behind the bearer token carried by the context
+func (tm *TokenManager) Authenticate(ctx context.Context) (Principal, error) {
+	tokenString, ok := ctx.Value(kitjwt.JWTTokenContextKey).(string)
+	if !ok {
+		return Principal{}, helper.ErrMissingCredentials
+	}
+
+	claims, err := tm.ParseAccessToken(tokenString)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	// access tokens die with the session they were issued for
+	if claims.SessionId != "" && tm.sessions != nil {
+		active, err := tm.sessions.IsSessionActive(claims.SessionId)
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return Principal{}, err
+		}
+		if !active {
+			return Principal{}, helper.ErrSessionRevoked
+		}
+	}
+
+	return claims.Principal(), nil
+}
+
+// newClaims builds the claims of a token of a given type
+func (tm *TokenManager) newClaims(user user_service.UserORM, scopes []string, tokenType string,
+	issuedAt time.Time, expiry time.Duration) Claims {
+	return Claims{
+		UserId:      user.Id,
+		AccountType: user.UserAccountType,
+		AccountID:   user.AccountID,
+		Scopes:      scopes,
+		TokenType:   tokenType,
+		StandardClaims: jwt.StandardClaims{
+			Id:        NewTokenId(),
+			Subject:   strconv.Itoa(int(user.Id)),
+			Issuer:    tm.issuer,
+			IssuedAt:  issuedAt.Unix(),
+			NotBefore: issuedAt.Unix(),
+			ExpiresAt: issuedAt.Add(expiry).Unix(),
+		},
+	}
+}
+
+// parse verifies the signature, issuer and lifetime of a token of a given type and returns its claims
+func (tm *TokenManager) parse(tokenString string, tokenType string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, tm.verificationKeyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Valid || claims.Issuer != tm.issuer || claims.TokenType != tokenType {
+		return nil, helper.ErrUnauthorized
+	}
+
+	return claims, nil
+}
+
+// verificationKeyFunc resolves the public key a token was signed with from its kid header
+func (tm *TokenManager) verificationKeyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := tm.keys.verificationKey(kid)
+	if !ok {
+		return nil, helper.ErrUnauthorized
+	}
+
+	// Don't forget to validate the alg is what you expect
+	if token.Method != key.method {
+		return nil, kitjwt.ErrUnexpectedSigningMethod
+	}
+	return key.public, nil
+}
+
+// isExpired reports whether a token failed validation solely because it expired
+func isExpired(err error) bool {
+	e, ok := err.(*jwt.ValidationError)
+	return ok && e.Errors&jwt.ValidationErrorExpired != 0
+}
+
+// JSONWebKeySet returns the public keys tokens may be verified with
+func (tm *TokenManager) JSONWebKeySet() JSONWebKeySet {
+	return tm.keys.JSONWebKeySet()
+}
+
+// OpenIDConfiguration returns the discovery document of the authorization server
+func (tm *TokenManager) OpenIDConfiguration() OpenIDConfiguration {
+	return OpenIDConfiguration{
+		Issuer:                            tm.issuer,
+		AuthorizationEndpoint:             tm.publicUrl + "/v1/oauth/authorize",
+		TokenEndpoint:                     tm.publicUrl + "/v1/oauth/token",
+		JWKSURI:                           tm.publicUrl + "/.well-known/jwks.json",
+		ScopesSupported:                   []string{ScopeRead, ScopeWrite},
+		ResponseTypesSupported:            []string{CodeResponseType},
+		GrantTypesSupported:               []string{AuthorizationCodeGrantType, RefreshTokenGrantType},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{tm.keys.Algorithm()},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{CodeChallengeMethodS256},
+	}
+}
+
+// sign serializes a set of claims and signs them with the current signing key
+func (tm *TokenManager) sign(claims jwt.Claims) (string, error) {
+	key, err := tm.keys.signingKey()
+	if err != nil {
+		return "", err
+	}
+
+	return signWith(key, claims)
+}
+
+// signWith serializes a set of claims and signs them with a given key
+func signWith(key *signingKey, claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.id
+	return token.SignedString(key.private)
+}
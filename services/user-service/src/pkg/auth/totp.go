@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// totpPeriod is the lifetime of a single time based one time password (RFC 6238)
+	totpPeriod = 30 * time.Second
+	// totpDigits is the amount of digits of a time based one time password
+	totpDigits = 6
+	// totpSkew is the amount of periods before and after the current one a password is accepted for
+	// in order to tolerate clock drift between the service and authenticator apps
+	totpSkew = 1
+	// totpSecretSize is the size in bytes of generated secrets
+	totpSecretSize = 20
+
+	// recoveryCodeCount is the amount of recovery codes issued once two factor authentication is enabled
+	recoveryCodeCount = 10
+)
+
+// totpEncoding encodes secrets the way authenticator apps expect them
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generates a random base32 encoded secret shared with an authenticator app
+func NewTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI returns the otpauth uri authenticator apps are provisioned with. The uri is usually
+// rendered as a QR code
+func TOTPURI(issuer, accountName, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprintf("%d", totpDigits))
+	values.Set("period", fmt.Sprintf("%d", int(totpPeriod.Seconds())))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(accountName)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+// ValidateTOTP checks a one time password against a secret at a given time. The time step the
+// password was generated for is returned so that callers can reject passwords which were
+// already used
+func ValidateTOTP(secret, code string, at time.Time) (step int64, ok bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := at.Unix() / int64(totpPeriod.Seconds())
+	for i := -totpSkew; i <= totpSkew; i++ {
+		candidate := current + int64(i)
+		expected := totpCode(key, candidate)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return candidate, true
+		}
+	}
+
+	return 0, false
+}
+
+// totpCode computes the one time password of a given time step (RFC 4226)
+func totpCode(key []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+// NewRecoveryCodes generates the single use codes a user may log in with should they lose
+// access to their authenticator app
+func NewRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(b))
+		codes = append(codes, code[:4]+"-"+code[4:])
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode strips the formatting of a recovery code prior to hashing it
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+}
@@ -0,0 +1,122 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// APIKeyORM witholds the hash of an api key issued to machine clients. An api key either
+// belongs to a user or to a team and carries its own set of scopes
+type APIKeyORM struct {
+	Id          int32 `gorm:"primary_key"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	Name        string
+	Prefix      string
+	KeyHash     string `gorm:"unique_index" json:"-"`
+	UserId      *int32 `gorm:"index"`
+	TeamId      *int32 `gorm:"index"`
+	CreatedById int32
+	AccountID   string
+	Scopes      pq.StringArray `gorm:"type:text[]"`
+	ExpiresAt   *time.Time
+	RevokedAt   *time.Time
+	LastUsedAt  *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (APIKeyORM) TableName() string {
+	return APIKeysTableName
+}
+
+func (db *Database) CreateAPIKey(key *APIKeyORM) error {
+	if err := db.Engine.Create(key).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetAPIKeyById(id int32) (error, *APIKeyORM) {
+	var foundKey APIKeyORM
+
+	// attempt to obtain an api key from the database with this id
+	if err := db.Engine.Where("id = ?", id).First(&foundKey).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundKey
+}
+
+func (db *Database) GetAPIKeyByHash(hash string) (error, *APIKeyORM) {
+	var foundKey APIKeyORM
+
+	// attempt to obtain an api key from the database with this hash
+	if err := db.Engine.Where("key_hash = ?", hash).First(&foundKey).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundKey
+}
+
+// GetAPIKeysByOwner obtains every api key belonging to a given user as well as, if a team id
+// is provided, every api key belonging to that team
+func (db *Database) GetAPIKeysByOwner(userId int32, teamId int32) (error, []*APIKeyORM) {
+	var keys []*APIKeyORM
+
+	query := db.Engine.Where("user_id = ?", userId)
+	if teamId != 0 {
+		query = query.Or("team_id = ?", teamId)
+	}
+
+	if err := query.Order("created_at desc").Find(&keys).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, keys
+}
+
+func (db *Database) RevokeAPIKey(id int32) error {
+	now := time.Now()
+	err := db.Engine.Model(&APIKeyORM{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", &now).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RevokeUserAPIKeys revokes every active api key belonging to a given user
+func (db *Database) RevokeUserAPIKeys(userId int32) error {
+	now := time.Now()
+	err := db.Engine.Model(&APIKeyORM{}).
+		Where("user_id = ? AND revoked_at IS NULL", userId).
+		Update("revoked_at", &now).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// TouchAPIKey records the last time an api key was used to authenticate a request
+func (db *Database) TouchAPIKey(id int32) error {
+	err := db.Engine.Model(&APIKeyORM{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", time.Now()).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
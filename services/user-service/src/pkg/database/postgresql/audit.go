@@ -0,0 +1,33 @@
+package postgresql
+
+import (
+	"time"
+)
+
+// AuditRecordORM describes an operation a staff member performed while impersonating a user
+type AuditRecordORM struct {
+	Id        int32 `gorm:"primary_key"`
+	CreatedAt *time.Time
+	ActorId   int32 `gorm:"index"`
+	SubjectId int32 `gorm:"index"`
+	AccountID string
+	Operation string
+	Outcome   string
+	Error     string
+	IpAddress string
+	UserAgent string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (AuditRecordORM) TableName() string {
+	return AuditRecordsTableName
+}
+
+func (db *Database) CreateAuditRecord(record AuditRecordORM) error {
+	if err := db.Engine.Create(&record).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
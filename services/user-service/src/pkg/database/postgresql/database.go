@@ -1,37 +1,129 @@
 package postgresql
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
 	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
 )
 
 type IDatabase interface {
-	CreateUser(User table.UserORM) error
-	UpdateUser(User table.UserORM) error
-	DeleteUser(User table.UserORM) error
-	GetUserById(id int32) (error, *table.UserORM)
-	GetUserByUsername(username string) (error, *table.UserORM)
-	GetUserByEmail(email string) (error, *table.UserORM)
-	GetAllUsers(limit int) (error, []*table.UserORM)
+	CreateUser(ctx context.Context, User table.UserORM) error
+	UpdateUser(ctx context.Context, User table.UserORM) error
+	PatchUser(ctx context.Context, User table.UserORM, updateMask *field_mask.FieldMask) (error, *table.UserORM)
+	DeleteUser(ctx context.Context, User table.UserORM) error
+	RestoreUser(ctx context.Context, id int32, deletedAfter time.Time) error
+	GetDeletedUsers(deletedBefore time.Time, limit int) (error, []*table.UserORM)
+	PurgeUser(id int32) error
+	GetUserById(ctx context.Context, id int32) (error, *table.UserORM)
+	GetUserByUsername(ctx context.Context, username string) (error, *table.UserORM)
+	GetUserByEmail(ctx context.Context, email string) (error, *table.UserORM)
+	ListUsers(ctx context.Context, q ListQuery) (error, []*table.UserORM)
+	SearchUsers(ctx context.Context, query string, accountType string, limit int) (error, []*table.UserORM)
+	ActivateUser(userId int32, email string) error
+	SetUserResetToken(userId int32, tokenHash string, expiresAt time.Time) error
+	GetUserByResetToken(tokenHash string) (error, *table.UserORM)
+	ResetUserPassword(userId int32, tokenHash string, hashedPassword string) error
+	ChangeUserPassword(userId int32, currentHash string, hashedPassword string) error
+	UpdatePasswordHash(userId int32, currentHash string, upgradedHash string) error
+	AddPasswordHistory(userId int32, hashedPassword string, keep int) error
+	GetPasswordHistory(userId int32, limit int) (error, []*PasswordHistoryORM)
+	CreateEmailChange(change EmailChangeORM) error
+	GetEmailChangeByHash(tokenHash string) (error, *EmailChangeORM)
+	ConfirmEmailChange(change EmailChangeORM) error
+	SaveTwoFactorSecret(userId int32, ciphertext string) error
+	GetTwoFactor(userId int32) (error, *TwoFactorORM)
+	EnableTwoFactor(userId int32, step int64, recoveryCodeHashes []string) error
+	UseTwoFactorStep(userId int32, step int64) error
+	RedeemRecoveryCode(userId int32, codeHash string) error
+	DisableTwoFactor(userId int32) error
+	CreateLoginActivity(activity table.LoginActivityORM) error
+	GetLoginActivity(userId int32, beforeId int32, limit int) (error, []*table.LoginActivityORM)
+	UpdateLastLogin(userId int32, at time.Time, location string) error
+	GetLoginThrottle(scope string, identifier string) (error, *LoginThrottleORM)
+	RecordLoginFailure(scope string, identifier string, window time.Duration) (error, *LoginThrottleORM)
+	LockLogin(scope string, identifier string, until time.Time) error
+	ResetLoginThrottle(scope string, identifier string) error
 
 	CreateGroup(group table.GroupORM) error
 	UpdateGroup(group table.GroupORM) error
 	DeleteGroup(group table.GroupORM) error
 	GetGroupById(id int32) (error, *table.GroupORM)
 	GetGroupByName(name string) (error, *table.GroupORM)
-	GetAllGroups(limit int) (error, []*table.GroupORM)
+	ListGroups(q ListQuery) (error, []*table.GroupORM)
+
+	GetMembership(resourceType string, resourceId int32, userId int32) (error, *MembershipORM)
+	GetMemberships(resourceType string, resourceId int32) (error, []*MembershipORM)
+	SetMembership(membership MembershipORM) error
+	RemoveMembership(resourceType string, resourceId int32, userId int32) error
 
 	CreateTeam(group table.TeamORM) error
 	UpdateTeam(group table.TeamORM) error
+	UpdateTeamCredentials(teamId int32, email string, hashedPassword string) error
 	DeleteTeam(group table.TeamORM) error
 	GetTeamById(id int32) (error, *table.TeamORM)
 	GetTeamByName(name string) (error, *table.TeamORM)
-	GetAllTeams(limit int) (error, []*table.TeamORM)
+	GetTeamByEmail(email string) (error, *table.TeamORM)
+	SetTeamAdmin(teamId int32, userId int32) error
+	SetTeamResetToken(teamId int32, tokenHash string, expiresAt time.Time) error
+	GetTeamByResetToken(tokenHash string) (error, *table.TeamORM)
+	ResetTeamPassword(teamId int32, tokenHash string, hashedPassword string) error
+	ListTeams(q ListQuery) (error, []*table.TeamORM)
+
+	CreateRefreshToken(token RefreshTokenORM) error
+	GetRefreshTokenByHash(hash string) (error, *RefreshTokenORM)
+	RotateRefreshToken(current RefreshTokenORM, next RefreshTokenORM) error
+	RevokeRefreshTokenFamily(familyId string) error
+	RevokeUserRefreshTokens(userId int32) error
+	RevokeTeamRefreshTokens(teamId int32) error
+
+	CreateSession(session SessionORM) error
+	GetSessionByFamily(familyId string) (error, *SessionORM)
+	GetActiveSessions(userId int32) (error, []*SessionORM)
+	TouchSession(familyId string, ipAddress string, seenAt time.Time, expiresAt time.Time) error
+	RevokeSession(userId int32, id int32) error
+	IsSessionActive(familyId string) (bool, error)
+
+	CreateAuditRecord(record AuditRecordORM) error
+
+	CreateDataExport(export *DataExportORM) error
+	GetDataExport(id int32) (error, *DataExportORM)
+	GetPendingDataExports(limit int) (error, []*DataExportORM)
+	CompleteDataExport(id int32, archive []byte, expiresAt time.Time) error
+	FailDataExport(id int32, reason string) error
+	DeleteExpiredDataExports() error
+	GetUserData(userId int32) (error, *UserData)
+
+	EraseUser(ctx context.Context, id int32, seal func(receipt *ErasureReceiptORM) error) (error, *ErasureReceiptORM)
+	GetErasureReceipt(receiptId string) (error, *ErasureReceiptORM)
+
+	CreateAPIKey(key *APIKeyORM) error
+	GetAPIKeyById(id int32) (error, *APIKeyORM)
+	GetAPIKeyByHash(hash string) (error, *APIKeyORM)
+	GetAPIKeysByOwner(userId int32, teamId int32) (error, []*APIKeyORM)
+	RevokeAPIKey(id int32) error
+	RevokeUserAPIKeys(userId int32) error
+	TouchAPIKey(id int32) error
+
+	CreateOAuthClient(client *OAuthClientORM) error
+	GetOAuthClientByClientId(clientId string) (error, *OAuthClientORM)
+	GetOAuthConsent(userId int32, clientId string) (error, *OAuthConsentORM)
+	SaveOAuthConsent(consent OAuthConsentORM) error
+	CreateAuthorizationCode(code OAuthAuthorizationCodeORM) error
+	GetAuthorizationCodeByHash(hash string) (error, *OAuthAuthorizationCodeORM)
+	RedeemAuthorizationCode(id int32) error
+
+	CreateSigningKey(key *SigningKeyORM) error
+	GetSigningKeys() (error, []*SigningKeyORM)
+	RetireSigningKeys(currentId int32, expiresAt time.Time) error
+	PinSigningKey(keyId string) error
+	DeleteExpiredSigningKeys() error
 }
 
 type Database struct {
@@ -96,4 +188,26 @@ func CreateTablesOrMigrateSchemas(db *gorm.DB, zapLogger *zap.Logger) {
 	table.TeamORM{},table.TeamProfileORM{}, table.InvestorDetailORM{}, table.StartupDetailORM{}, table.SettingsORM{}, table.LoginActivityORM{},
 	table.PaymentsORM{}, table.CardORM{}, table.PinORM{}, table.Privacy{},table.NotificationORM{}, table.PostAndCommentsPushNotificationORM{},
 	table.FollowingAndFollowersPushNotificationORM{}, table.DirectMessagesPushNotificationORM{}, table.EmailAndSmsPushNotificationORM{})
+
+	// tables owned by the service rather than generated from the proto definitions
+	db.AutoMigrate(RefreshTokenORM{}, APIKeyORM{}, OAuthClientORM{}, OAuthAuthorizationCodeORM{}, OAuthConsentORM{}, SigningKeyORM{}, EmailChangeORM{},
+		TwoFactorORM{}, RecoveryCodeORM{}, LoginThrottleORM{}, PasswordHistoryORM{}, SessionORM{},
+		MembershipORM{}, AuditRecordORM{}, DataExportORM{}, ErasureReceiptORM{})
+
+	// users persisted before queries were scoped to accounts belong to the default account
+	err := db.Model(&table.UserORM{}).Where("account_id IS NULL OR account_id = ''").
+		Update("account_id", config.Config.DefaultAccountID).Error
+	if err != nil {
+		zapLogger.Error(err.Error())
+	}
+
+	// the profile of a user and the address of a profile are referenced by columns the generated models lack
+	if err := migrateProfileReferences(db); err != nil {
+		zapLogger.Error(err.Error())
+	}
+
+	// roles are granted to the admins, members and advisors referenced by teams and groups
+	if err := migrateMemberships(db); err != nil {
+		zapLogger.Error(err.Error())
+	}
 }
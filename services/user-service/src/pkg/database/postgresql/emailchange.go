@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// EmailChangeORM witholds an email address a user asked to switch to alongside the hash of the
+// token confirming ownership of it. A user holds at most one pending email change
+type EmailChangeORM struct {
+	Id          int32 `gorm:"primary_key"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	UserId      int32  `gorm:"index"`
+	NewEmail    string `gorm:"index"`
+	TokenHash   string `gorm:"unique_index" json:"-"`
+	ExpiresAt   *time.Time
+	ConfirmedAt *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (EmailChangeORM) TableName() string {
+	return EmailChangesTableName
+}
+
+// CreateEmailChange records a pending email change, replacing any change the user previously
+// requested. The new address may neither belong to nor be pending for another user
+func (db *Database) CreateEmailChange(change EmailChangeORM) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND confirmed_at IS NULL", change.UserId).
+			Delete(&EmailChangeORM{}).Error; err != nil {
+			return err
+		}
+
+		if err := ensureEmailAvailable(tx, change.NewEmail); err != nil {
+			return err
+		}
+
+		return tx.Create(&change).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetEmailChangeByHash(tokenHash string) (error, *EmailChangeORM) {
+	var foundChange EmailChangeORM
+
+	// attempt to obtain a pending email change from the database with this token hash
+	if err := db.Engine.Where("token_hash = ?", tokenHash).First(&foundChange).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundChange
+}
+
+// ConfirmEmailChange consumes a pending email change and swaps the email of its user
+func (db *Database) ConfirmEmailChange(change EmailChangeORM) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// only confirm the change if no other request confirmed it first
+		result := tx.Model(&EmailChangeORM{}).
+			Where("id = ? AND confirmed_at IS NULL", change.Id).
+			Update("confirmed_at", &now)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return helper.ErrInvalidEmailChangeToken
+		}
+
+		// deleted users keep their email until purged so that they may be restored
+		var count int
+		if err := tx.Unscoped().Model(&table.UserORM{}).Where("email = ?", change.NewEmail).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return helper.ErrAlreadyExists
+		}
+
+		return tx.Model(&table.UserORM{}).Where("id = ?", change.UserId).Update("email", change.NewEmail).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ensureEmailAvailable checks that an email address neither belongs to a user, deleted or not, nor is
+// pending confirmation by one
+func ensureEmailAvailable(tx *gorm.DB, email string) error {
+	var count int
+	if err := tx.Unscoped().Model(&table.UserORM{}).Where("email = ?", email).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return helper.ErrAlreadyExists
+	}
+
+	if err := tx.Model(&EmailChangeORM{}).
+		Where("new_email = ? AND confirmed_at IS NULL AND expires_at > ?", email, time.Now()).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return helper.ErrAlreadyExists
+	}
+
+	return nil
+}
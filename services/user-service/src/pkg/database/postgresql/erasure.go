@@ -0,0 +1,392 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	atlasauth "github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/jinzhu/gorm"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
+)
+
+// erasedEmailDomain is the reserved domain the emails of erased users are rewritten to
+const erasedEmailDomain = "erased.invalid"
+
+// ErasedTables maps the tables touched while erasing a user to the number of rows anonymized or deleted
+type ErasedTables map[string]int64
+
+// Value serializes the erased tables so that they may be persisted
+func (t ErasedTables) Value() (driver.Value, error) {
+	data, err := json.Marshal(t)
+	return string(data), err
+}
+
+// Scan deserializes persisted erased tables
+func (t *ErasedTables) Scan(value interface{}) error {
+	return scanJSON(value, t)
+}
+
+// OwnershipReassignment records the team or group an erased user owned alongside the member the
+// ownership was handed over to. Resources left without any member have no new owner
+type OwnershipReassignment struct {
+	ResourceType string `json:"resource_type"`
+	ResourceId   int32  `json:"resource_id"`
+	OwnerId      int32  `json:"owner_id,omitempty"`
+}
+
+// OwnershipReassignments lists the ownerships handed over while erasing a user
+type OwnershipReassignments []OwnershipReassignment
+
+// Value serializes the reassignments so that they may be persisted
+func (r OwnershipReassignments) Value() (driver.Value, error) {
+	data, err := json.Marshal(r)
+	return string(data), err
+}
+
+// Scan deserializes persisted reassignments
+func (r *OwnershipReassignments) Scan(value interface{}) error {
+	return scanJSON(value, r)
+}
+
+// ErasureReceiptORM attests that the personal data of a user was erased. The signed receipt may be
+// verified against the published json web key set, in which the key signing it is pinned
+type ErasureReceiptORM struct {
+	Id            int32                  `gorm:"primary_key" json:"-"`
+	CreatedAt     *time.Time             `json:"-"`
+	ReceiptId     string                 `gorm:"unique_index" json:"receipt_id"`
+	UserId        int32                  `gorm:"unique_index" json:"user_id"`
+	AccountID     string                 `json:"account_id"`
+	ActorId       int32                  `json:"actor_id"`
+	ErasedAt      time.Time              `json:"erased_at"`
+	Tables        ErasedTables           `gorm:"type:text" json:"tables"`
+	Reassignments OwnershipReassignments `gorm:"type:text" json:"reassignments"`
+	Receipt       string                 `gorm:"type:text" json:"receipt"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ErasureReceiptORM) TableName() string {
+	return ErasureReceiptsTableName
+}
+
+// EraseUser anonymizes the personal data held about a user of the account the context is scoped to
+// across every table, hands over the teams and groups they own and revokes their credentials. The
+// receipt is sealed before being persisted alongside the erasure. Erasing a user twice returns the
+// original receipt
+func (db *Database) EraseUser(ctx context.Context, id int32, seal func(receipt *ErasureReceiptORM) error) (error, *ErasureReceiptORM) {
+	var receipt ErasureReceiptORM
+
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		accountID, err := atlasauth.GetAccountID(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		// deleted users are erased as well
+		var user table.UserORM
+		err = tx.Unscoped().Set("gorm:query_option", "FOR UPDATE").
+			Where("id = ? AND account_id = ?", id, accountID).First(&user).Error
+		if err != nil {
+			return err
+		}
+
+		if user.ProfileId, err = userProfile(tx, id); err != nil {
+			return err
+		}
+
+		err = tx.Where("user_id = ?", id).First(&receipt).Error
+		if err == nil || !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+
+		receipt = ErasureReceiptORM{UserId: id, AccountID: user.AccountID, ErasedAt: time.Now(), Tables: ErasedTables{}}
+		if receipt.Reassignments, err = reassignOwnership(tx, id, receipt.Tables); err != nil {
+			return err
+		}
+
+		if err := anonymizeUser(tx, &user, receipt.Tables); err != nil {
+			return err
+		}
+
+		if err := deleteUserCredentials(tx, &user, receipt.Tables); err != nil {
+			return err
+		}
+
+		if err := seal(&receipt); err != nil {
+			return err
+		}
+		return tx.Create(&receipt).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &receipt
+}
+
+func (db *Database) GetErasureReceipt(receiptId string) (error, *ErasureReceiptORM) {
+	var receipt ErasureReceiptORM
+
+	if err := db.Engine.Where("receipt_id = ?", receiptId).First(&receipt).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &receipt
+}
+
+// reassignOwnership hands the teams and groups solely owned by a user over to their most privileged
+// remaining member, oldest first, and revokes every role the user holds. The legacy admin reference
+// of a team or group follows its new owner
+func reassignOwnership(tx *gorm.DB, userId int32, tables ErasedTables) (OwnershipReassignments, error) {
+	var owned []*MembershipORM
+	if err := tx.Where("user_id = ? AND role = ?", userId, rbac.RoleOwner).Order("id").Find(&owned).Error; err != nil {
+		return nil, err
+	}
+
+	reassignments := OwnershipReassignments{}
+	for _, membership := range owned {
+		var owners int
+		err := tx.Model(&MembershipORM{}).
+			Where("resource_type = ? AND resource_id = ? AND role = ? AND user_id <> ?",
+				membership.ResourceType, membership.ResourceId, rbac.RoleOwner, userId).
+			Count(&owners).Error
+		if err != nil {
+			return nil, err
+		}
+
+		// co-owned resources keep their remaining owners
+		if owners > 0 {
+			continue
+		}
+
+		reassignment := OwnershipReassignment{ResourceType: membership.ResourceType, ResourceId: membership.ResourceId}
+
+		var successor MembershipORM
+		err = tx.Where("resource_type = ? AND resource_id = ? AND user_id <> ?",
+			membership.ResourceType, membership.ResourceId, userId).
+			Order(gorm.Expr("CASE role WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END", rbac.RoleAdmin, rbac.RoleMember)).
+			Order("id").First(&successor).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return nil, err
+		}
+
+		if err == nil {
+			if err := tx.Model(&successor).Update("role", rbac.RoleOwner).Error; err != nil {
+				return nil, err
+			}
+
+			column := "admin_id_team_id"
+			if membership.ResourceType == rbac.GroupResource {
+				column = "admin_group_id"
+			}
+			if err := tx.Model(&table.UserORM{}).Where("id = ?", successor.UserId).Update(column, membership.ResourceId).Error; err != nil {
+				return nil, err
+			}
+			reassignment.OwnerId = successor.UserId
+		}
+
+		reassignments = append(reassignments, reassignment)
+	}
+
+	result := tx.Where("user_id = ?", userId).Delete(&MembershipORM{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	tables[MembershipsTableName] = result.RowsAffected
+
+	return reassignments, nil
+}
+
+// anonymization blanks the columns of the rows of a table matching a condition. Rows known to hold
+// personal data are expected to be matched
+type anonymization struct {
+	table    string
+	model    interface{}
+	where    string
+	args     []interface{}
+	values   map[string]interface{}
+	expected int64
+}
+
+// anonymizeUser blanks the personal data held about a user in the user, profile, address, education,
+// experience, social media, settings, payment, log in activity and audit tables. The user is deleted and
+// every reference they hold to a team or group is cleared. The erasure fails if any row known to hold
+// personal data was left untouched
+func anonymizeUser(tx *gorm.DB, user *table.UserORM, tables ErasedTables) error {
+	tx = tx.Unscoped()
+
+	// log in attempts rejected before the user was resolved are only attributed by username
+	loginActivity := "user_id = ? OR lower(username) = lower(?)"
+	loginActivityArgs := []interface{}{user.Id, user.UserName}
+
+	updates := []anonymization{
+		{
+			table: table.UserORM{}.TableName(), model: &table.UserORM{}, where: "id = ?", args: []interface{}{user.Id},
+			values: map[string]interface{}{
+				"first_name": "", "last_name": "", "email": fmt.Sprintf("erased-%d@%s", user.Id, erasedEmailDomain),
+				"user_name": fmt.Sprintf("erased-%d", user.Id), "phone_number": "", "birth_date": "", "gender": "",
+				"languages": "", "intent": "", "age": 0, "password": "", "password_confirmed": "", "reset_token": "",
+				"reset_token_expiration": nil, "is_active": false, "admin_id_team_id": nil, "admin_group_id": nil,
+				"advisors_team_id": nil, "members_team_id": nil, "group_members_group_id": nil,
+				"deleted_at": gorm.Expr("COALESCE(deleted_at, ?)", time.Now()),
+			},
+			expected: 1,
+		},
+		{
+			table: table.SettingsORM{}.TableName(), model: &table.SettingsORM{}, where: "user_id = ?", args: []interface{}{user.Id},
+			values: map[string]interface{}{"last_login": nil, "last_login_location": ""},
+		},
+		{
+			table: table.CardORM{}.TableName(), model: &table.CardORM{},
+			where: "credit_card_payments_id IN (?) OR debit_card_payments_id IN (?)",
+			args:  []interface{}{userPayments(tx, user.Id), userPayments(tx, user.Id)},
+			values: map[string]interface{}{"card_number": "", "security_code": "", "full_name": "", "address": "",
+				"city": "", "state": "", "zipcode": "", "card_zip_code": ""},
+		},
+		{
+			table: table.PinORM{}.TableName(), model: &table.PinORM{}, where: "payments_id IN (?)",
+			args:   []interface{}{userPayments(tx, user.Id)},
+			values: map[string]interface{}{"pin": "", "pin_enabled": false},
+		},
+		{
+			table: table.AddressORM{}.TableName(), model: &table.AddressORM{}, where: "login_activity_id IN (?)",
+			args:   []interface{}{tx.Model(&table.LoginActivityORM{}).Select("id").Where(loginActivity, loginActivityArgs...).QueryExpr()},
+			values: blankAddress,
+		},
+		{
+			table: table.LoginActivityORM{}.TableName(), model: &table.LoginActivityORM{}, where: loginActivity,
+			args: loginActivityArgs, values: map[string]interface{}{"ip_address": "", "user_agent": "", "username": ""},
+		},
+		{
+			table: AuditRecordsTableName, model: &AuditRecordORM{}, where: "actor_id = ? OR subject_id = ?",
+			args: []interface{}{user.Id, user.Id}, values: map[string]interface{}{"ip_address": "", "user_agent": ""},
+		},
+	}
+
+	if profile := user.ProfileId; profile != nil {
+		updates = append(updates, []anonymization{
+			{
+				table: table.ProfileORM{}.TableName(), model: &table.ProfileORM{}, where: "id = ?", args: []interface{}{profile.Id},
+				values:   map[string]interface{}{"bio": "", "avatar_url": "", "nationality": "", "skills": nil},
+				expected: 1,
+			},
+			{
+				table: table.EducationORM{}.TableName(), model: &table.EducationORM{}, where: "profile_id = ?",
+				args: []interface{}{profile.Id},
+				values: map[string]interface{}{"school": "", "degree": "", "field_of_study": "", "activities": "",
+					"societies": "", "description": "", "gpa": 0, "start_date": nil, "end_date": nil},
+				expected: int64(len(profile.EducationId)),
+			},
+		}...)
+
+		if profile.SocialMediaId != nil {
+			updates = append(updates, anonymization{
+				table: table.SocialMediaORM{}.TableName(), model: &table.SocialMediaORM{}, where: "id = ?",
+				args: []interface{}{*profile.SocialMediaId},
+				values: map[string]interface{}{"facebook_url": "", "github_url": 0, "linked_url": "", "twitter_url": "",
+					"website_url": "", "youtube_url": ""},
+				expected: 1,
+			})
+		}
+
+		if profile.AddressId != nil {
+			updates = append(updates, anonymization{
+				table: table.AddressORM{}.TableName(), model: &table.AddressORM{}, where: "id = ?",
+				args: []interface{}{profile.AddressId.Id}, values: blankAddress, expected: 1,
+			})
+		}
+
+		experienceIds := []int32{0}
+		for _, experience := range profile.ExperienceId {
+			experienceIds = append(experienceIds, experience.Id)
+		}
+		updates = append(updates, anonymization{
+			table: table.ExperienceORM{}.TableName(), model: &table.ExperienceORM{}, where: "id IN (?)",
+			args: []interface{}{experienceIds},
+			values: map[string]interface{}{"company_name": "", "description": "", "employment_type": "", "headline": "",
+				"location": "", "title": "", "start_date": nil, "end_date": nil},
+			expected: int64(len(profile.ExperienceId)),
+		})
+	}
+
+	for _, update := range updates {
+		result := tx.Model(update.model).Where(update.where, update.args...).UpdateColumns(update.values)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected < update.expected {
+			return helper.ErrErasureIncomplete
+		}
+		tables[update.table] += result.RowsAffected
+	}
+
+	return nil
+}
+
+// blankAddress anonymizes an address
+var blankAddress = map[string]interface{}{"building_number": "", "street": "", "city": "", "state": "", "country": "",
+	"zip_code": "", "latitude": "", "longitude": ""}
+
+// userPayments returns a sub query selecting the payments attached to the settings of a user
+func userPayments(tx *gorm.DB, userId int32) interface{} {
+	settings := tx.Model(&table.SettingsORM{}).Select("id").Where("user_id = ?", userId).QueryExpr()
+	return tx.Model(&table.PaymentsORM{}).Select("id").Where("settings_id IN (?)", settings).QueryExpr()
+}
+
+// deleteUserCredentials deletes the credentials, sessions, tokens, pending changes and exports of a user
+// as well as the log in throttle of their username
+func deleteUserCredentials(tx *gorm.DB, user *table.UserORM, tables ErasedTables) error {
+	deletions := []struct {
+		table string
+		model interface{}
+		where string
+		args  []interface{}
+	}{
+		{RefreshTokensTableName, &RefreshTokenORM{}, "user_id = ?", []interface{}{user.Id}},
+		{SessionsTableName, &SessionORM{}, "user_id = ?", []interface{}{user.Id}},
+		{APIKeysTableName, &APIKeyORM{}, "user_id = ?", []interface{}{user.Id}},
+		{OAuthConsentsTableName, &OAuthConsentORM{}, "user_id = ?", []interface{}{user.Id}},
+		{OAuthAuthorizationCodesTableName, &OAuthAuthorizationCodeORM{}, "user_id = ?", []interface{}{user.Id}},
+		{EmailChangesTableName, &EmailChangeORM{}, "user_id = ?", []interface{}{user.Id}},
+		{TwoFactorsTableName, &TwoFactorORM{}, "user_id = ?", []interface{}{user.Id}},
+		{RecoveryCodesTableName, &RecoveryCodeORM{}, "user_id = ?", []interface{}{user.Id}},
+		{PasswordHistoriesTableName, &PasswordHistoryORM{}, "user_id = ?", []interface{}{user.Id}},
+		{DataExportsTableName, &DataExportORM{}, "user_id = ?", []interface{}{user.Id}},
+		{LoginThrottlesTableName, &LoginThrottleORM{}, "identifier = ?", []interface{}{strings.ToLower(user.UserName)}},
+	}
+
+	for _, deletion := range deletions {
+		result := tx.Where(deletion.where, deletion.args...).Delete(deletion.model)
+		if result.Error != nil {
+			return result.Error
+		}
+		tables[deletion.table] = result.RowsAffected
+	}
+
+	return nil
+}
+
+// scanJSON deserializes a persisted JSON column
+func scanJSON(value interface{}, into interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, into)
+	case string:
+		return json.Unmarshal([]byte(v), into)
+	default:
+		return errors.New("unsupported json column type")
+	}
+}
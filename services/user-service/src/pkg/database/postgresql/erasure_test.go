@@ -0,0 +1,49 @@
+package postgresql
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+func TestAnonymizeUserBlanksLoginActivityAndAudits(t *testing.T) {
+	scripted := &scriptedDriver{}
+	sql.Register("scripted_erasure", scripted)
+
+	sqlDB, err := sql.Open("scripted_erasure", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables := ErasedTables{}
+	if err := anonymizeUser(engine, &table.UserORM{Id: 1, UserName: "Ada"}, tables); err != nil {
+		t.Fatal(err)
+	}
+
+	updates := []string{
+		`UPDATE "login_activities" SET "ip_address" = $1, "user_agent" = $2, "username" = $3  ` +
+			`WHERE (user_id = $4 OR lower(username) = lower($5))[   1 Ada]`,
+		`UPDATE "audit_records" SET "ip_address" = $1, "user_agent" = $2  WHERE (actor_id = $3 OR subject_id = $4)[  1 1]`,
+	}
+
+	executed := strings.Join(scripted.executed, "\n")
+	for _, update := range updates {
+		if !strings.Contains(executed, update) {
+			t.Errorf("expected %q to be executed, got:\n%s", update, executed)
+		}
+	}
+
+	for _, name := range []string{table.LoginActivityORM{}.TableName(), AuditRecordsTableName} {
+		if tables[name] == 0 {
+			t.Errorf("expected the receipt to record %s, got %v", name, tables)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+const (
+	// DataExportPending marks an export waiting to be built
+	DataExportPending = "pending"
+	// DataExportCompleted marks an export whose archive may be downloaded
+	DataExportCompleted = "completed"
+	// DataExportFailed marks an export which could not be built
+	DataExportFailed = "failed"
+)
+
+// DataExportORM describes an export of the data held about a user. The archive is only kept until
+// the export expires
+type DataExportORM struct {
+	Id          int32 `gorm:"primary_key"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	UserId      int32  `gorm:"index"`
+	Status      string `gorm:"index"`
+	Archive     []byte `json:"-"`
+	Error       string `json:"-"`
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (DataExportORM) TableName() string {
+	return DataExportsTableName
+}
+
+// UserData gathers every record held about a user
+type UserData struct {
+	User          *table.UserORM
+	Profile       *table.ProfileORM
+	Settings      []*table.SettingsORM
+	LoginActivity []*table.LoginActivityORM
+	Memberships   []*MembershipORM
+}
+
+// CreateDataExport records a pending export for a user. A user may only have a single pending export,
+// the pending export is returned if one already exists
+func (db *Database) CreateDataExport(export *DataExportORM) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ? AND status = ?", export.UserId, DataExportPending).First(export).Error
+		if err == nil || !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+
+		export.Status = DataExportPending
+		return tx.Create(export).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetDataExport(id int32) (error, *DataExportORM) {
+	var export DataExportORM
+
+	if err := db.Engine.Where("id = ?", id).First(&export).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &export
+}
+
+// GetPendingDataExports lists the exports waiting to be built, oldest first
+func (db *Database) GetPendingDataExports(limit int) (error, []*DataExportORM) {
+	var exports []*DataExportORM
+
+	err := db.Engine.Where("status = ?", DataExportPending).Order("id").Limit(limit).Find(&exports).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, exports
+}
+
+// CompleteDataExport stores the archive of a pending export
+func (db *Database) CompleteDataExport(id int32, archive []byte, expiresAt time.Time) error {
+	now := time.Now()
+	err := db.Engine.Model(&DataExportORM{}).Where("id = ? AND status = ?", id, DataExportPending).
+		Updates(map[string]interface{}{
+			"status":       DataExportCompleted,
+			"archive":      archive,
+			"completed_at": &now,
+			"expires_at":   &expiresAt,
+		}).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// FailDataExport marks a pending export as failed
+func (db *Database) FailDataExport(id int32, reason string) error {
+	err := db.Engine.Model(&DataExportORM{}).Where("id = ? AND status = ?", id, DataExportPending).
+		Updates(map[string]interface{}{"status": DataExportFailed, "error": reason}).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpiredDataExports deletes the exports whose archive may no longer be downloaded
+func (db *Database) DeleteExpiredDataExports() error {
+	if err := db.Engine.Where("expires_at < ?", time.Now()).Delete(&DataExportORM{}).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetUserData gathers the user, their profile alongside its address, education, experience and social
+// media, their settings, subscriptions, log in activity and team and group memberships
+func (db *Database) GetUserData(userId int32) (error, *UserData) {
+	var data UserData
+
+	var user table.UserORM
+	if err := db.Engine.Preload("SubscriptionsId").Where("id = ?", userId).First(&user).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+	data.User = &user
+
+	profile, err := userProfile(db.Engine, userId)
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+	data.Profile = profile
+
+	if err := db.Engine.Where("user_id = ?", userId).Order("id").Find(&data.Settings).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	if err := db.Engine.Where("user_id = ?", userId).Order("id").Find(&data.LoginActivity).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	if err := db.Engine.Where("user_id = ?", userId).Order("id").Find(&data.Memberships).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &data
+}
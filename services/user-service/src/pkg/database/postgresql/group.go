@@ -2,11 +2,12 @@ package postgresql
 
 import (
 	"context"
-	"errors"
 
 	"github.com/jinzhu/gorm"
 
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
 	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
 )
 
 func (db *Database) CreateGroup(group table.GroupORM) error {
@@ -24,18 +25,19 @@ func (db *Database) CreateGroup(group table.GroupORM) error {
 			return err
 		}
 
-		// check if group exists based on id or group name fields
-		// Note: email and username fields are unique so if a db entity witholds those respective parameters
-		// we know the team already exists
-		if err = tx.Where("Id = ?", foundGroup.Id).Or("GroupName = ?", pbGroup.Name).Find(&foundGroup).Error; err != nil {
+		// check if group exists based on the group name field
+		// Note: the name field is unique so if a db entity witholds this parameter
+		// we know the group already exists
+		err = tx.Where("name = ?", pbGroup.Name).Find(&foundGroup).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
 			return err
 		}
 
 		if foundGroup.Name != "" {
-			return errors.New("Group already exists")
+			return helper.ErrAlreadyExists
 		}
 
-		// save the user to the database
+		// save the group to the database
 		if err := tx.Create(group).Error; err != nil {
 			return err
 		}
@@ -44,6 +46,7 @@ func (db *Database) CreateGroup(group table.GroupORM) error {
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
@@ -72,6 +75,7 @@ func (db *Database) UpdateGroup(group table.GroupORM) error {
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
@@ -101,11 +105,13 @@ func (db *Database) DeleteGroup(group table.GroupORM) error {
 			return err
 		}
 
-		return nil
+		// roles held on the group die with it
+		return deleteMemberships(tx, rbac.GroupResource, group.Id)
 	})
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
@@ -133,7 +139,7 @@ func (db *Database) GetGroupByName(groupname string) (error, *table.GroupORM) {
 	var foundGroup table.GroupORM
 
 	// attempt to obtain a group from the database with this username
-	if err := db.Engine.Where("groupname = ?", groupname).First(&foundGroup).Error; err != nil {
+	if err := db.Engine.Where("name = ?", groupname).First(&foundGroup).Error; err != nil {
 		db.Logger.Error(err.Error())
 		return err, nil
 	}
@@ -146,25 +152,3 @@ func (db *Database) GetGroupByName(groupname string) (error, *table.GroupORM) {
 
 	return nil, &foundGroup
 }
-
-func (db *Database) GetAllGroups(limit int) (error, []*table.GroupORM) {
-	var groups []*table.GroupORM
-	var group table.GroupORM
-
-	// find all groups in the groups tables but do not breach limit
-	rows, err := db.Engine.Limit(limit).Find(table.GroupORM{}).Rows()
-	if err != nil {
-		db.Logger.Error(err.Error())
-		return err, nil
-	}
-
-	for rows.Next() {
-		if err := rows.Scan(&group); err != nil {
-			return err, nil
-		}
-
-		groups = append(groups, &group)
-	}
-
-	return nil, groups
-}
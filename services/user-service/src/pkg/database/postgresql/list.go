@@ -0,0 +1,222 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	atlasauth "github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// Keys listings may be sorted by. Ties are broken by id
+const (
+	SortById        = "id"
+	SortByCreatedAt = "created_at"
+	SortByName      = "name"
+)
+
+// ListQuery filters, sorts and bounds a listing of users, teams or groups. Filters left empty are not
+// applied
+type ListQuery struct {
+	SortBy        string
+	Descending    bool
+	After         *helper.Cursor
+	Limit         int
+	AccountType   string
+	IsActive      *bool
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Industry      string
+	Tags          []string
+}
+
+// Validate checks that a listing may be sorted by the requested key and that the cursor was obtained
+// from a listing sorted the same way
+func (q ListQuery) Validate() error {
+	switch q.SortBy {
+	case SortById, SortByCreatedAt, SortByName:
+	default:
+		return helper.ErrInvalidSortKey
+	}
+
+	if q.After != nil && (q.After.SortBy != q.SortBy || q.After.Descending != q.Descending) {
+		return helper.ErrInvalidPageToken
+	}
+
+	if q.CreatedAfter != nil && q.CreatedBefore != nil && !q.CreatedAfter.Before(*q.CreatedBefore) {
+		return helper.ErrInvalidArgumentProvided
+	}
+
+	return nil
+}
+
+// Cursor returns the cursor pointing past a listed record
+func (q ListQuery) Cursor(id int32, createdAt *time.Time, name string) helper.Cursor {
+	cursor := helper.Cursor{SortBy: q.SortBy, Descending: q.Descending, Id: id}
+
+	switch q.SortBy {
+	case SortByCreatedAt:
+		if createdAt != nil {
+			cursor.Value = createdAt.UTC().Format(time.RFC3339Nano)
+		}
+	case SortByName:
+		cursor.Value = name
+	}
+
+	return cursor
+}
+
+// listing describes the columns a kind of record is sorted and filtered on. Empty columns denote
+// filters the kind of record does not support
+type listing struct {
+	name     string
+	kind     string
+	active   string
+	industry string
+	tags     string
+}
+
+var (
+	userListing  = listing{name: "user_name", kind: "user_account_type", active: "is_active"}
+	teamListing  = listing{name: "name", kind: "type", active: "is_active", industry: "industry", tags: "tags"}
+	groupListing = listing{name: "name", kind: "type", tags: "tags"}
+)
+
+// scope applies the filters, sort order, cursor and limit of a query
+func (l listing) scope(db *gorm.DB, q ListQuery) (*gorm.DB, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	if (q.IsActive != nil && l.active == "") || (q.Industry != "" && l.industry == "") ||
+		(len(q.Tags) > 0 && l.tags == "") {
+		return nil, helper.ErrUnsupportedFilter
+	}
+
+	if q.AccountType != "" {
+		db = db.Where(l.kind+" = ?", q.AccountType)
+	}
+	if q.IsActive != nil {
+		db = db.Where(l.active+" = ?", *q.IsActive)
+	}
+	if q.CreatedAfter != nil {
+		db = db.Where("created_at >= ?", *q.CreatedAfter)
+	}
+	if q.CreatedBefore != nil {
+		db = db.Where("created_at < ?", *q.CreatedBefore)
+	}
+	if q.Industry != "" {
+		db = db.Where(l.industry+" = ?", q.Industry)
+	}
+	if len(q.Tags) > 0 {
+		// records must hold every requested tag
+		db = db.Where(l.tags+" @> ?", pq.StringArray(q.Tags))
+	}
+
+	column := SortById
+	switch q.SortBy {
+	case SortByCreatedAt:
+		column = "created_at"
+	case SortByName:
+		column = l.name
+	}
+
+	order, comparison := "ASC", ">"
+	if q.Descending {
+		order, comparison = "DESC", "<"
+	}
+
+	if q.After != nil {
+		after, err := q.afterValue()
+		if err != nil {
+			return nil, err
+		}
+
+		if column == SortById {
+			db = db.Where(fmt.Sprintf("id %s ?", comparison), q.After.Id)
+		} else {
+			db = db.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, comparison), after, q.After.Id)
+		}
+	}
+
+	if column != SortById {
+		db = db.Order(fmt.Sprintf("%s %s", column, order))
+	}
+
+	return db.Order("id " + order).Limit(q.Limit), nil
+}
+
+// afterValue parses the sort value held by the cursor of a query. Listings sorted by id only rely on
+// the id of the cursor
+func (q ListQuery) afterValue() (interface{}, error) {
+	switch q.SortBy {
+	case SortByCreatedAt:
+		createdAt, err := time.Parse(time.RFC3339Nano, q.After.Value)
+		if err != nil {
+			return nil, helper.ErrInvalidPageToken
+		}
+		return createdAt, nil
+	case SortByName:
+		return q.After.Value, nil
+	}
+	return nil, nil
+}
+
+// ListUsers lists the users of the account the context is scoped to. Deleted users are left out
+func (db *Database) ListUsers(ctx context.Context, q ListQuery) (error, []*table.UserORM) {
+	accountID, err := atlasauth.GetAccountID(ctx, nil)
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	scoped, err := userListing.scope(db.Engine.Where("account_id = ?", accountID), q)
+	if err != nil {
+		return err, nil
+	}
+
+	var users []*table.UserORM
+	if err := scoped.Find(&users).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, users
+}
+
+// ListTeams lists teams. Deleted teams are left out
+func (db *Database) ListTeams(q ListQuery) (error, []*table.TeamORM) {
+	scoped, err := teamListing.scope(db.Engine, q)
+	if err != nil {
+		return err, nil
+	}
+
+	var teams []*table.TeamORM
+	if err := scoped.Find(&teams).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, teams
+}
+
+// ListGroups lists groups. Deleted groups are left out
+func (db *Database) ListGroups(q ListQuery) (error, []*table.GroupORM) {
+	scoped, err := groupListing.scope(db.Engine, q)
+	if err != nil {
+		return err, nil
+	}
+
+	var groups []*table.GroupORM
+	if err := scoped.Find(&groups).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, groups
+}
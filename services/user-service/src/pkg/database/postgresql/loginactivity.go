@@ -0,0 +1,33 @@
+package postgresql
+
+import (
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// CreateLoginActivity records a log in attempt
+func (db *Database) CreateLoginActivity(activity table.LoginActivityORM) error {
+	if err := db.Engine.Create(&activity).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetLoginActivity lists the log in attempts made for a given user, most recent first. Only attempts
+// preceding the provided activity id are returned unless it is zero
+func (db *Database) GetLoginActivity(userId int32, beforeId int32, limit int) (error, []*table.LoginActivityORM) {
+	var activities []*table.LoginActivityORM
+
+	query := db.Engine.Where("user_id = ?", userId)
+	if beforeId != 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	if err := query.Order("id desc").Limit(limit).Find(&activities).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, activities
+}
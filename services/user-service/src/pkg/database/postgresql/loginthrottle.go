@@ -0,0 +1,79 @@
+package postgresql
+
+import (
+	"time"
+)
+
+// LoginThrottleORM counts the consecutive failed log in attempts made for a given username or
+// from a given ip address. Failures older than the lockout window no longer count
+type LoginThrottleORM struct {
+	Id            int32 `gorm:"primary_key"`
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+	Scope         string `gorm:"unique_index:idx_login_throttles_scope_identifier"`
+	Identifier    string `gorm:"unique_index:idx_login_throttles_scope_identifier"`
+	Failures      int32
+	LastFailureAt *time.Time
+	LockedUntil   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (LoginThrottleORM) TableName() string {
+	return LoginThrottlesTableName
+}
+
+func (db *Database) GetLoginThrottle(scope string, identifier string) (error, *LoginThrottleORM) {
+	var foundThrottle LoginThrottleORM
+
+	// attempt to obtain the failure counter of this username or ip address
+	if err := db.Engine.Where("scope = ? AND identifier = ?", scope, identifier).First(&foundThrottle).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundThrottle
+}
+
+// RecordLoginFailure atomically increments the failure counter of a username or ip address and
+// returns its new state. The counter restarts if the previous failure precedes the given window
+func (db *Database) RecordLoginFailure(scope string, identifier string, window time.Duration) (error, *LoginThrottleORM) {
+	now := time.Now()
+	query := "INSERT INTO " + LoginThrottlesTableName + " (created_at, updated_at, scope, identifier, failures, last_failure_at) " +
+		"VALUES (?, ?, ?, ?, 1, ?) " +
+		"ON CONFLICT (scope, identifier) DO UPDATE SET " +
+		"failures = CASE WHEN " + LoginThrottlesTableName + ".last_failure_at < ? THEN 1 " +
+		"ELSE " + LoginThrottlesTableName + ".failures + 1 END, " +
+		"last_failure_at = EXCLUDED.last_failure_at, updated_at = EXCLUDED.updated_at"
+
+	if err := db.Engine.Exec(query, now, now, scope, identifier, now, now.Add(-window)).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return db.GetLoginThrottle(scope, identifier)
+}
+
+// LockLogin prevents log in attempts for a username or from an ip address until a given time
+func (db *Database) LockLogin(scope string, identifier string, until time.Time) error {
+	err := db.Engine.Model(&LoginThrottleORM{}).
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		Update("locked_until", until).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ResetLoginThrottle clears the failure counter and any lock of a username or ip address
+func (db *Database) ResetLoginThrottle(scope string, identifier string) error {
+	err := db.Engine.Where("scope = ? AND identifier = ?", scope, identifier).
+		Delete(&LoginThrottleORM{}).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
+)
+
+// MembershipORM describes the role a user holds on a team or a group. A user holds at most one
+// role on a given team or group
+type MembershipORM struct {
+	Id           int32 `gorm:"primary_key"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	ResourceType string `gorm:"unique_index:idx_membership_resource_user"`
+	ResourceId   int32  `gorm:"unique_index:idx_membership_resource_user"`
+	UserId       int32  `gorm:"unique_index:idx_membership_resource_user;index"`
+	Role         string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (MembershipORM) TableName() string {
+	return MembershipsTableName
+}
+
+func (db *Database) GetMembership(resourceType string, resourceId int32, userId int32) (error, *MembershipORM) {
+	var membership MembershipORM
+
+	err := db.Engine.Where("resource_type = ? AND resource_id = ? AND user_id = ?", resourceType, resourceId, userId).
+		First(&membership).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &membership
+}
+
+func (db *Database) GetMemberships(resourceType string, resourceId int32) (error, []*MembershipORM) {
+	var memberships []*MembershipORM
+
+	err := db.Engine.Where("resource_type = ? AND resource_id = ?", resourceType, resourceId).
+		Order("id").Find(&memberships).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, memberships
+}
+
+// SetMembership grants a role on a team or group to a user, replacing any role they held on it.
+// Owners may not be demoted if no other owner remains
+func (db *Database) SetMembership(membership MembershipORM) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		var current MembershipORM
+		err := tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("resource_type = ? AND resource_id = ? AND user_id = ?",
+				membership.ResourceType, membership.ResourceId, membership.UserId).
+			First(&current).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+
+		if gorm.IsRecordNotFoundError(err) {
+			return tx.Create(&membership).Error
+		}
+
+		if err := tx.Model(&current).Update("role", membership.Role).Error; err != nil {
+			return err
+		}
+
+		if current.Role == rbac.RoleOwner && membership.Role != rbac.RoleOwner {
+			return ensureOwnerRemains(tx, membership.ResourceType, membership.ResourceId)
+		}
+		return nil
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMembership revokes the role a user holds on a team or group. The last owner may not be removed
+func (db *Database) RemoveMembership(resourceType string, resourceId int32, userId int32) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		var current MembershipORM
+		err := tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("resource_type = ? AND resource_id = ? AND user_id = ?", resourceType, resourceId, userId).
+			First(&current).Error
+		if err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return helper.ErrNotFound
+			}
+			return err
+		}
+
+		if err := tx.Delete(&current).Error; err != nil {
+			return err
+		}
+
+		if current.Role == rbac.RoleOwner {
+			return ensureOwnerRemains(tx, resourceType, resourceId)
+		}
+		return nil
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ensureOwnerRemains fails if a team or group is left without any owner
+func ensureOwnerRemains(tx *gorm.DB, resourceType string, resourceId int32) error {
+	var owners int
+	err := tx.Model(&MembershipORM{}).
+		Where("resource_type = ? AND resource_id = ? AND role = ?", resourceType, resourceId, rbac.RoleOwner).
+		Count(&owners).Error
+	if err != nil {
+		return err
+	}
+
+	if owners == 0 {
+		return helper.ErrLastOwner
+	}
+	return nil
+}
+
+// deleteMemberships revokes every role held on a team or group
+func deleteMemberships(tx *gorm.DB, resourceType string, resourceId int32) error {
+	return tx.Where("resource_type = ? AND resource_id = ?", resourceType, resourceId).Delete(&MembershipORM{}).Error
+}
+
+// migrateMemberships grants roles to the users referenced as admins, members and advisors by teams and
+// groups persisted before roles were tracked. Roles already held are left untouched
+func migrateMemberships(db *gorm.DB) error {
+	legacyRoles := []struct {
+		resourceType string
+		column       string
+		role         string
+	}{
+		{rbac.TeamResource, "admin_id_team_id", rbac.RoleOwner},
+		{rbac.TeamResource, "members_team_id", rbac.RoleMember},
+		{rbac.TeamResource, "advisors_team_id", rbac.RoleAdvisor},
+		{rbac.GroupResource, "admin_group_id", rbac.RoleOwner},
+		{rbac.GroupResource, "group_members_group_id", rbac.RoleMember},
+	}
+
+	for _, legacy := range legacyRoles {
+		err := db.Exec("INSERT INTO "+MembershipsTableName+" (created_at, updated_at, resource_type, resource_id, user_id, role) "+
+			"SELECT now(), now(), ?, "+legacy.column+", id, ? FROM users WHERE "+legacy.column+" IS NOT NULL "+
+			"ON CONFLICT DO NOTHING", legacy.resourceType, legacy.role).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
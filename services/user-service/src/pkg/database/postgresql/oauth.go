@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// OAuthClientORM witholds a third party application registered against the oauth2
+// authorization server. Public clients hold no secret and must rely on PKCE alone
+type OAuthClientORM struct {
+	Id               int32 `gorm:"primary_key"`
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	ClientId         string `gorm:"unique_index"`
+	ClientSecretHash string `json:"-"`
+	Name             string
+	RedirectURIs     pq.StringArray `gorm:"type:text[]"`
+	Scopes           pq.StringArray `gorm:"type:text[]"`
+	Public           bool
+	OwnerId          int32 `gorm:"index"`
+	RevokedAt        *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OAuthClientORM) TableName() string {
+	return OAuthClientsTableName
+}
+
+// OAuthAuthorizationCodeORM witholds the hash of a single use authorization code alongside
+// the PKCE challenge it was issued for
+type OAuthAuthorizationCodeORM struct {
+	Id                  int32 `gorm:"primary_key"`
+	CreatedAt           *time.Time
+	CodeHash            string `gorm:"unique_index"`
+	ClientId            string `gorm:"index"`
+	UserId              int32
+	RedirectURI         string
+	Scopes              pq.StringArray `gorm:"type:text[]"`
+	CodeChallenge       string
+	CodeChallengeMethod string
+	FamilyId            string
+	ExpiresAt           *time.Time
+	UsedAt              *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OAuthAuthorizationCodeORM) TableName() string {
+	return OAuthAuthorizationCodesTableName
+}
+
+// OAuthConsentORM records the scopes a user consented to grant to an oauth client
+type OAuthConsentORM struct {
+	Id        int32 `gorm:"primary_key"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	UserId    int32          `gorm:"unique_index:idx_oauth_consent_user_client"`
+	ClientId  string         `gorm:"unique_index:idx_oauth_consent_user_client"`
+	Scopes    pq.StringArray `gorm:"type:text[]"`
+	RevokedAt *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OAuthConsentORM) TableName() string {
+	return OAuthConsentsTableName
+}
+
+func (db *Database) CreateOAuthClient(client *OAuthClientORM) error {
+	if err := db.Engine.Create(client).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetOAuthClientByClientId(clientId string) (error, *OAuthClientORM) {
+	var foundClient OAuthClientORM
+
+	// attempt to obtain an oauth client from the database with this client id
+	if err := db.Engine.Where("client_id = ?", clientId).First(&foundClient).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundClient
+}
+
+func (db *Database) GetOAuthConsent(userId int32, clientId string) (error, *OAuthConsentORM) {
+	var foundConsent OAuthConsentORM
+
+	// attempt to obtain the consent a user granted to a given client
+	if err := db.Engine.Where("user_id = ? AND client_id = ?", userId, clientId).First(&foundConsent).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundConsent
+}
+
+// SaveOAuthConsent creates or updates the consent a user granted to a given client
+func (db *Database) SaveOAuthConsent(consent OAuthConsentORM) error {
+	if err := db.Engine.Save(&consent).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) CreateAuthorizationCode(code OAuthAuthorizationCodeORM) error {
+	if err := db.Engine.Create(&code).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetAuthorizationCodeByHash(hash string) (error, *OAuthAuthorizationCodeORM) {
+	var foundCode OAuthAuthorizationCodeORM
+
+	// attempt to obtain an authorization code from the database with this hash
+	if err := db.Engine.Where("code_hash = ?", hash).First(&foundCode).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundCode
+}
+
+// RedeemAuthorizationCode marks an authorization code as used. Codes may only be redeemed once
+func (db *Database) RedeemAuthorizationCode(id int32) error {
+	result := db.Engine.Model(&OAuthAuthorizationCodeORM{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		db.Logger.Error(result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return helper.ErrInvalidGrant
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// SetUserResetToken stores the hash of a password reset token issued to a given user, replacing
+// any token previously issued to them
+func (db *Database) SetUserResetToken(userId int32, tokenHash string, expiresAt time.Time) error {
+	err := db.Engine.Model(&table.UserORM{}).
+		Where("id = ?", userId).
+		Updates(map[string]interface{}{"reset_token": tokenHash, "reset_token_expiration": expiresAt}).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetUserByResetToken(tokenHash string) (error, *table.UserORM) {
+	var foundUser table.UserORM
+
+	// attempt to obtain a user from the database with this reset token
+	if err := db.Engine.Where("reset_token = ?", tokenHash).First(&foundUser).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundUser
+}
+
+// ResetUserPassword replaces the password of a given user and consumes their reset token. The update
+// only succeeds if the reset token was not consumed by a concurrent request
+func (db *Database) ResetUserPassword(userId int32, tokenHash string, hashedPassword string) error {
+	result := db.Engine.Model(&table.UserORM{}).
+		Where("id = ? AND reset_token = ?", userId, tokenHash).
+		Updates(map[string]interface{}{
+			"password":               hashedPassword,
+			"password_confirmed":     hashedPassword,
+			"reset_token":            "",
+			"reset_token_expiration": nil,
+		})
+	if result.Error != nil {
+		db.Logger.Error(result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		db.Logger.Error(helper.ErrInvalidResetToken.Error())
+		return helper.ErrInvalidResetToken
+	}
+
+	return nil
+}
+
+// ChangeUserPassword replaces the password of a given user. The update only succeeds if the password
+// was not changed by a concurrent request since the current one was verified
+func (db *Database) ChangeUserPassword(userId int32, currentHash string, hashedPassword string) error {
+	result := db.Engine.Model(&table.UserORM{}).
+		Where("id = ? AND password = ?", userId, currentHash).
+		Updates(map[string]interface{}{"password": hashedPassword, "password_confirmed": hashedPassword})
+	if result.Error != nil {
+		db.Logger.Error(result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		db.Logger.Error(helper.ErrInvalidPasswordProvided.Error())
+		return helper.ErrInvalidPasswordProvided
+	}
+
+	return nil
+}
+
+// UpdatePasswordHash replaces the password hash of a given user with an upgraded hash of the same
+// password. The hash is left untouched if the password was changed concurrently
+func (db *Database) UpdatePasswordHash(userId int32, currentHash string, upgradedHash string) error {
+	err := db.Engine.Model(&table.UserORM{}).
+		Where("id = ? AND password = ?", userId, currentHash).
+		Updates(map[string]interface{}{"password": upgradedHash, "password_confirmed": upgradedHash}).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// SetTeamResetToken stores the hash of a password reset token issued to a given team, replacing
+// any token previously issued to it
+func (db *Database) SetTeamResetToken(teamId int32, tokenHash string, expiresAt time.Time) error {
+	err := db.Engine.Model(&table.TeamORM{}).
+		Where("id = ?", teamId).
+		Updates(map[string]interface{}{"reset_token": tokenHash, "reset_token_expiration": expiresAt}).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetTeamByResetToken(tokenHash string) (error, *table.TeamORM) {
+	var foundTeam table.TeamORM
+
+	// attempt to obtain a team from the database with this reset token
+	if err := db.Engine.Where("reset_token = ?", tokenHash).First(&foundTeam).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundTeam
+}
+
+// ResetTeamPassword replaces the password of a given team and consumes its reset token. The update
+// only succeeds if the reset token was not consumed by a concurrent request
+func (db *Database) ResetTeamPassword(teamId int32, tokenHash string, hashedPassword string) error {
+	result := db.Engine.Model(&table.TeamORM{}).
+		Where("id = ? AND reset_token = ?", teamId, tokenHash).
+		Updates(map[string]interface{}{
+			"password":               hashedPassword,
+			"reset_token":            "",
+			"reset_token_expiration": nil,
+		})
+	if result.Error != nil {
+		db.Logger.Error(result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		db.Logger.Error(helper.ErrInvalidResetToken.Error())
+		return helper.ErrInvalidResetToken
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// PasswordHistoryORM witholds the hash of a password a user set at some point so that recently
+// used passwords can not be reused
+type PasswordHistoryORM struct {
+	Id             int32 `gorm:"primary_key"`
+	CreatedAt      *time.Time
+	UserId         int32  `gorm:"index"`
+	HashedPassword string `json:"-"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PasswordHistoryORM) TableName() string {
+	return PasswordHistoriesTableName
+}
+
+// AddPasswordHistory records the hash of a password set by a given user and forgets all but the
+// given amount of most recent passwords
+func (db *Database) AddPasswordHistory(userId int32, hashedPassword string, keep int) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&PasswordHistoryORM{UserId: userId, HashedPassword: hashedPassword}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("user_id = ? AND id NOT IN (?)", userId,
+			tx.Model(&PasswordHistoryORM{}).Select("id").Where("user_id = ?", userId).
+				Order("id desc").Limit(keep).SubQuery()).
+			Delete(&PasswordHistoryORM{}).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetPasswordHistory obtains the hashes of the most recent passwords of a given user
+func (db *Database) GetPasswordHistory(userId int32, limit int) (error, []*PasswordHistoryORM) {
+	var history []*PasswordHistoryORM
+
+	if err := db.Engine.Where("user_id = ?", userId).Order("id desc").Limit(limit).Find(&history).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, history
+}
@@ -0,0 +1,89 @@
+package postgresql
+
+import (
+	"github.com/jinzhu/gorm"
+
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// The generated models declare the profile of a user and the address of a profile as belongs to
+// associations without declaring their foreign keys, so gorm neither persists nor preloads them. The
+// references are kept in the profile_id and address_id columns added by migrateProfileReferences and
+// resolved by userProfile instead
+const (
+	userProfileColumn    = "profile_id"
+	profileAddressColumn = "address_id"
+)
+
+// migrateProfileReferences adds the columns referencing the profile of a user and the address of a
+// profile
+func migrateProfileReferences(db *gorm.DB) error {
+	references := []struct {
+		table  string
+		column string
+	}{
+		{table.UserORM{}.TableName(), userProfileColumn},
+		{table.ProfileORM{}.TableName(), profileAddressColumn},
+	}
+
+	for _, reference := range references {
+		err := db.Exec("ALTER TABLE " + reference.table + " ADD COLUMN IF NOT EXISTS " + reference.column + " integer").Error
+		if err != nil {
+			return err
+		}
+
+		err = db.Exec("CREATE INDEX IF NOT EXISTS idx_" + reference.table + "_" + reference.column +
+			" ON " + reference.table + " (" + reference.column + ")").Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reference holds the id a reference column points to, if any
+type reference struct {
+	Id *int32
+}
+
+// userProfile loads the profile of a user, deleted or not, alongside its address, education,
+// experience and social media. Users without a profile have a nil profile
+func userProfile(tx *gorm.DB, userId int32) (*table.ProfileORM, error) {
+	var profileRef reference
+	err := tx.Table(table.UserORM{}.TableName()).Select(userProfileColumn+" AS id").
+		Where("id = ?", userId).Scan(&profileRef).Error
+	if err != nil || profileRef.Id == nil {
+		return nil, ignoreNotFound(err)
+	}
+
+	var profile table.ProfileORM
+	err = tx.Unscoped().Preload("EducationId").Preload("ExperienceId").Preload("SocialMedia").
+		Where("id = ?", *profileRef.Id).First(&profile).Error
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+
+	var addressRef reference
+	err = tx.Table(table.ProfileORM{}.TableName()).Select(profileAddressColumn+" AS id").
+		Where("id = ?", profile.Id).Scan(&addressRef).Error
+	if err != nil || addressRef.Id == nil {
+		return &profile, ignoreNotFound(err)
+	}
+
+	var address table.AddressORM
+	err = tx.Unscoped().Where("id = ?", *addressRef.Id).First(&address).Error
+	if err == nil {
+		profile.AddressId = &address
+	}
+
+	return &profile, ignoreNotFound(err)
+}
+
+// ignoreNotFound treats missing records as absent rather than failed lookups
+func ignoreNotFound(err error) error {
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// RefreshTokenORM witholds the hash of a refresh token issued to a user or to a team. Tokens
+// rotated from one another share a family id so that the replay of an already rotated token
+// can revoke every descendant. Tokens issued to oauth clients record the client id
+// as well as the scopes consented to
+type RefreshTokenORM struct {
+	Id        int32 `gorm:"primary_key"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	UserId    int32          `gorm:"index"`
+	TeamId    int32          `gorm:"index"`
+	FamilyId  string         `gorm:"index"`
+	ClientId  string         `gorm:"index"`
+	Scopes    pq.StringArray `gorm:"type:text[]"`
+	TokenHash string         `gorm:"unique_index"`
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RefreshTokenORM) TableName() string {
+	return RefreshTokensTableName
+}
+
+func (db *Database) CreateRefreshToken(token RefreshTokenORM) error {
+	if err := db.Engine.Create(&token).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetRefreshTokenByHash(hash string) (error, *RefreshTokenORM) {
+	var foundToken RefreshTokenORM
+
+	// attempt to obtain a refresh token from the database with this hash
+	if err := db.Engine.Where("token_hash = ?", hash).First(&foundToken).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundToken
+}
+
+// RotateRefreshToken revokes the current refresh token and persists its successor in a single
+// transaction. Should the current token have been revoked concurrently, the rotation is aborted
+func (db *Database) RotateRefreshToken(current RefreshTokenORM, next RefreshTokenORM) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// only revoke the current token if no other request rotated it first
+		result := tx.Model(&RefreshTokenORM{}).
+			Where("id = ? AND revoked_at IS NULL", current.Id).
+			Update("revoked_at", &now)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return helper.ErrRefreshTokenReused
+		}
+
+		if err := tx.Create(&next).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily revokes every active refresh token sharing a given family id as well as
+// the session spanning them
+func (db *Database) RevokeRefreshTokenFamily(familyId string) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Model(&RefreshTokenORM{}).
+			Where("family_id = ? AND revoked_at IS NULL", familyId).
+			Update("revoked_at", &now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&SessionORM{}).
+			Where("family_id = ? AND revoked_at IS NULL", familyId).
+			Update("revoked_at", &now).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RevokeUserRefreshTokens revokes every active refresh token issued to a given user as well as
+// every session of theirs
+func (db *Database) RevokeUserRefreshTokens(userId int32) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Model(&RefreshTokenORM{}).
+			Where("user_id = ? AND revoked_at IS NULL", userId).
+			Update("revoked_at", &now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&SessionORM{}).
+			Where("user_id = ? AND revoked_at IS NULL", userId).
+			Update("revoked_at", &now).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RevokeTeamRefreshTokens revokes every active refresh token issued to a given team as well as
+// every session of theirs
+func (db *Database) RevokeTeamRefreshTokens(teamId int32) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Model(&RefreshTokenORM{}).
+			Where("team_id = ? AND revoked_at IS NULL", teamId).
+			Update("revoked_at", &now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&SessionORM{}).
+			Where("team_id = ? AND revoked_at IS NULL", teamId).
+			Update("revoked_at", &now).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package postgresql
+
+import (
+	"time"
+)
+
+// SessionORM describes a session a user, or a team, established from a given device. A session
+// spans the family of refresh tokens issued on log in, it ends once the family is revoked
+type SessionORM struct {
+	Id         int32 `gorm:"primary_key"`
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+	UserId     int32  `gorm:"index"`
+	TeamId     int32  `gorm:"index"`
+	FamilyId   string `gorm:"unique_index" json:"-"`
+	ClientId   string
+	Device     string
+	IpAddress  string
+	LastSeenAt *time.Time
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	// Current is set on the session the listing request was made from
+	Current bool `gorm:"-"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (SessionORM) TableName() string {
+	return SessionsTableName
+}
+
+func (db *Database) CreateSession(session SessionORM) error {
+	if err := db.Engine.Create(&session).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetSessionByFamily(familyId string) (error, *SessionORM) {
+	var foundSession SessionORM
+
+	// attempt to obtain the session spanning this refresh token family
+	if err := db.Engine.Where("family_id = ?", familyId).First(&foundSession).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundSession
+}
+
+// GetActiveSessions obtains the sessions of a given user which were neither revoked nor expired,
+// most recently seen first
+func (db *Database) GetActiveSessions(userId int32) (error, []*SessionORM) {
+	var sessions []*SessionORM
+
+	err := db.Engine.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userId, time.Now()).
+		Order("last_seen_at desc").Find(&sessions).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, sessions
+}
+
+// TouchSession records the latest activity of a session and extends it until the given time
+func (db *Database) TouchSession(familyId string, ipAddress string, seenAt time.Time, expiresAt time.Time) error {
+	err := db.Engine.Model(&SessionORM{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyId).
+		Updates(map[string]interface{}{"ip_address": ipAddress, "last_seen_at": seenAt, "expires_at": expiresAt}).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RevokeSession ends an active session of a given user as well as the refresh tokens it spans
+func (db *Database) RevokeSession(userId int32, id int32) error {
+	var session SessionORM
+	if err := db.Engine.Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userId).First(&session).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return db.RevokeRefreshTokenFamily(session.FamilyId)
+}
+
+// IsSessionActive reports whether the session spanning a given refresh token family was neither
+// revoked nor expired
+func (db *Database) IsSessionActive(familyId string) (bool, error) {
+	err, session := db.GetSessionByFamily(familyId)
+	if err != nil {
+		return false, err
+	}
+
+	return session.RevokedAt == nil && session.ExpiresAt != nil && session.ExpiresAt.After(time.Now()), nil
+}
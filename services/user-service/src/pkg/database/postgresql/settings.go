@@ -0,0 +1,45 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// UpdateLastLogin records the time and location of the last successful log in of a user in their settings
+func (db *Database) UpdateLastLogin(userId int32, at time.Time, location string) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		return updateUserSettings(tx, userId, map[string]interface{}{
+			"last_login":          at,
+			"last_login_location": location,
+		})
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// updateUserSettings updates a set of columns of the settings of a given user. The settings are
+// created if the user has none yet
+func updateUserSettings(tx *gorm.DB, userId int32, fields map[string]interface{}) error {
+	result := tx.Model(&table.SettingsORM{}).Where("user_id = ?", userId).Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected != 0 {
+		return nil
+	}
+
+	if err := tx.Create(&table.SettingsORM{UserId: userId}).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&table.SettingsORM{}).Where("user_id = ?", userId).Updates(fields).Error
+}
@@ -0,0 +1,82 @@
+package postgresql
+
+import (
+	"time"
+)
+
+// SigningKeyORM witholds an asymmetric key pair used to sign the tokens minted by the service. The
+// private half is encrypted at rest. Retired keys no longer sign tokens but their public half remains
+// published until ExpiresAt, by which time every token they signed has expired. Pinned keys signed
+// erasure receipts, which never expire, and remain published indefinitely
+type SigningKeyORM struct {
+	Id         int32 `gorm:"primary_key"`
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+	KeyId      string `gorm:"unique_index"`
+	Algorithm  string
+	PrivateKey string `json:"-"`
+	PublicKey  string
+	RetiredAt  *time.Time
+	ExpiresAt  *time.Time `gorm:"index"`
+	Pinned     bool
+}
+
+// TableName overrides the default tablename generated by GORM
+func (SigningKeyORM) TableName() string {
+	return SigningKeysTableName
+}
+
+func (db *Database) CreateSigningKey(key *SigningKeyORM) error {
+	if err := db.Engine.Create(key).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetSigningKeys obtains every signing key which has not yet expired or is pinned, newest first
+func (db *Database) GetSigningKeys() (error, []*SigningKeyORM) {
+	var keys []*SigningKeyORM
+
+	if err := db.Engine.Where("pinned OR expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("created_at desc").Find(&keys).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, keys
+}
+
+// RetireSigningKeys retires every active signing key other than the current one. Retired keys
+// expire at the provided time
+func (db *Database) RetireSigningKeys(currentId int32, expiresAt time.Time) error {
+	if err := db.Engine.Model(&SigningKeyORM{}).
+		Where("id <> ? AND retired_at IS NULL", currentId).
+		Updates(map[string]interface{}{"retired_at": time.Now(), "expires_at": expiresAt}).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// PinSigningKey keeps a signing key published indefinitely
+func (db *Database) PinSigningKey(keyId string) error {
+	if err := db.Engine.Model(&SigningKeyORM{}).Where("key_id = ?", keyId).Update("pinned", true).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpiredSigningKeys purges the signing keys which are no longer needed to verify tokens
+func (db *Database) DeleteExpiredSigningKeys() error {
+	if err := db.Engine.Where("expires_at <= ? AND NOT pinned", time.Now()).Delete(&SigningKeyORM{}).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
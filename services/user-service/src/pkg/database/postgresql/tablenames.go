@@ -4,4 +4,32 @@ var (
 	UsersTableName  = "users_table"
 	TeamsTableName  = "teams_table"
 	GroupsTableName = "groups_table"
+
+	RefreshTokensTableName = "refresh_tokens"
+	APIKeysTableName       = "api_keys"
+
+	OAuthClientsTableName            = "oauth_clients"
+	OAuthAuthorizationCodesTableName = "oauth_authorization_codes"
+	OAuthConsentsTableName           = "oauth_consents"
+
+	SigningKeysTableName = "signing_keys"
+
+	EmailChangesTableName = "email_changes"
+
+	TwoFactorsTableName    = "two_factors"
+	RecoveryCodesTableName = "recovery_codes"
+
+	LoginThrottlesTableName = "login_throttles"
+
+	PasswordHistoriesTableName = "password_histories"
+
+	SessionsTableName = "sessions"
+
+	MembershipsTableName = "memberships"
+
+	AuditRecordsTableName = "audit_records"
+
+	DataExportsTableName = "data_exports"
+
+	ErasureReceiptsTableName = "erasure_receipts"
 )
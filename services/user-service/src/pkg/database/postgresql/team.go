@@ -2,11 +2,12 @@ package postgresql
 
 import (
 	"context"
-	"errors"
 
 	"github.com/jinzhu/gorm"
 
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
 	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
 )
 
 func (db *Database) CreateTeam(team table.TeamORM) error {
@@ -24,18 +25,19 @@ func (db *Database) CreateTeam(team table.TeamORM) error {
 			return err
 		}
 
-		// check if team exists based on email or teamname fields
-		// Note: email and username fields are unique so if a db entity witholds those respective parameters
+		// check if team exists based on email or name fields
+		// Note: email and name fields are unique so if a db entity witholds those respective parameters
 		// we know the team already exists
-		if err = tx.Where("email = ?", pbTeam.Email).Or("teamName = ?", pbTeam.Name).Find(&foundTeam).Error; err != nil {
+		err = tx.Where("email = ?", pbTeam.Email).Or("name = ?", pbTeam.Name).Find(&foundTeam).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
 			return err
 		}
 
 		if foundTeam.Email != "" {
-			return errors.New("team already exists")
+			return helper.ErrAlreadyExists
 		}
 
-		// save the user to the database
+		// save the team to the database
 		if err := tx.Create(team).Error; err != nil {
 			return err
 		}
@@ -44,11 +46,14 @@ func (db *Database) CreateTeam(team table.TeamORM) error {
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
 }
 
+// UpdateTeam updates the descriptive fields of a team. The email, password, reset token and state of a
+// team are left untouched, credentials are replaced through UpdateTeamCredentials instead
 func (db *Database) UpdateTeam(team table.TeamORM) error {
 	err := db.Engine.Transaction(func(tx *gorm.DB) error {
 		ctx := context.TODO()
@@ -62,16 +67,83 @@ func (db *Database) UpdateTeam(team table.TeamORM) error {
 			return err
 		}
 
-		// Updates all fields in a team entity in the database
-		if err = tx.Save(team).Error; err != nil {
+		// team names identify teams on log in hence are unique
+		if err := ensureTeamAvailable(tx, team.Id, "name = ?", team.Name); err != nil {
 			return err
 		}
 
+		result := tx.Model(&table.TeamORM{}).Where("id = ?", team.Id).Updates(map[string]interface{}{
+			"name":                team.Name,
+			"bio":                 team.Bio,
+			"type":                team.Type,
+			"industry":            team.Industry,
+			"founded_date":        team.FoundedDate,
+			"number_of_employees": team.NumberOfEmployees,
+			"phone_number":        team.PhoneNumber,
+			"tags":                team.Tags,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UpdateTeamCredentials replaces the email and, given a non empty hash, the password of a team. Any
+// pending password reset token is consumed since it was delivered to the previous email
+func (db *Database) UpdateTeamCredentials(teamId int32, email string, hashedPassword string) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		if err := ensureTeamAvailable(tx, teamId, "email = ?", email); err != nil {
+			return err
+		}
+
+		values := map[string]interface{}{"email": email, "reset_token": "", "reset_token_expiration": nil}
+		if hashedPassword != "" {
+			values["password"] = hashedPassword
+		}
+
+		result := tx.Model(&table.TeamORM{}).Where("id = ?", teamId).Updates(values)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
 		return nil
 	})
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ensureTeamAvailable checks that no team other than a given one matches a condition on one of the
+// unique fields of teams
+func ensureTeamAvailable(tx *gorm.DB, teamId int32, where string, value interface{}) error {
+	var count int
+	if err := tx.Model(&table.TeamORM{}).Where(where, value).Where("id <> ?", teamId).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return helper.ErrAlreadyExists
 	}
 
 	return nil
@@ -96,16 +168,18 @@ func (db *Database) DeleteTeam(team table.TeamORM) error {
 			return err
 		}
 
-		// user exists in the database hence perform deletion
+		// team exists in the database hence perform deletion
 		if err = tx.Where("email = ?", team.Email).Delete(&foundTeam).Error; err != nil {
 			return err
 		}
 
-		return nil
+		// roles held on the team die with it
+		return deleteMemberships(tx, rbac.TeamResource, team.Id)
 	})
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
@@ -132,8 +206,8 @@ func (db *Database) GetTeamById(id int32) (error, *table.TeamORM) {
 func (db *Database) GetTeamByName(teamName string) (error, *table.TeamORM) {
 	var foundTeam table.TeamORM
 
-	// attempt to obtain a user from the database with this username
-	if err := db.Engine.Where("teamname = ?", teamName).First(&foundTeam).Error; err != nil {
+	// attempt to obtain a team from the database with this name
+	if err := db.Engine.Where("name = ?", teamName).First(&foundTeam).Error; err != nil {
 		db.Logger.Error(err.Error())
 		return err, nil
 	}
@@ -147,24 +221,27 @@ func (db *Database) GetTeamByName(teamName string) (error, *table.TeamORM) {
 	return nil, &foundTeam
 }
 
-func (db *Database) GetAllTeams(limit int) (error, []*table.TeamORM) {
-	var teams []*table.TeamORM
-	var team table.TeamORM
+func (db *Database) GetTeamByEmail(email string) (error, *table.TeamORM) {
+	var foundTeam table.TeamORM
 
-	// find all teams in the teams tables but do not breach limit
-	rows, err := db.Engine.Limit(limit).Find(table.TeamORM{}).Rows()
-	if err != nil {
+	// attempt to obtain a team from the database with this email
+	if err := db.Engine.Where("email = ?", email).First(&foundTeam).Error; err != nil {
 		db.Logger.Error(err.Error())
 		return err, nil
 	}
 
-	for rows.Next() {
-		if err := rows.Scan(&team); err != nil {
-			return err, nil
-		}
+	return nil, &foundTeam
+}
 
-		teams = append(teams, &team)
+// SetTeamAdmin makes a given user the admin of a given team
+func (db *Database) SetTeamAdmin(teamId int32, userId int32) error {
+	err := db.Engine.Model(&table.UserORM{}).
+		Where("id = ?", userId).
+		Update("admin_id_team_id", teamId).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
 	}
 
-	return nil, teams
+	return nil
 }
@@ -0,0 +1,180 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// TwoFactorORM witholds the encrypted time based one time password secret of a user. Secrets
+// remain pending until the user proves their authenticator app was provisioned by presenting
+// a valid code. LastUsedStep records the time step of the last accepted code so that codes
+// cannot be replayed
+type TwoFactorORM struct {
+	Id           int32 `gorm:"primary_key"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	UserId       int32  `gorm:"unique_index"`
+	Secret       string `json:"-"`
+	LastUsedStep int64
+	EnabledAt    *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TwoFactorORM) TableName() string {
+	return TwoFactorsTableName
+}
+
+// RecoveryCodeORM witholds the hash of a single use code a user may complete two factor
+// authentication with in place of a one time password
+type RecoveryCodeORM struct {
+	Id        int32 `gorm:"primary_key"`
+	CreatedAt *time.Time
+	UserId    int32  `gorm:"index"`
+	CodeHash  string `gorm:"index" json:"-"`
+	UsedAt    *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RecoveryCodeORM) TableName() string {
+	return RecoveryCodesTableName
+}
+
+// SaveTwoFactorSecret records a pending two factor secret for a given user, replacing any
+// secret which was not confirmed yet
+func (db *Database) SaveTwoFactorSecret(userId int32, ciphertext string) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		var current TwoFactorORM
+		err := tx.Where("user_id = ?", userId).First(&current).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+
+		if current.EnabledAt != nil {
+			return helper.ErrTwoFactorAlreadyEnabled
+		}
+
+		if err := tx.Where("user_id = ?", userId).Delete(&TwoFactorORM{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&TwoFactorORM{UserId: userId, Secret: ciphertext}).Error
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetTwoFactor(userId int32) (error, *TwoFactorORM) {
+	var foundTwoFactor TwoFactorORM
+
+	// attempt to obtain the two factor secret of the user
+	if err := db.Engine.Where("user_id = ?", userId).First(&foundTwoFactor).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &foundTwoFactor
+}
+
+// EnableTwoFactor confirms the pending two factor secret of a user, replaces their recovery codes
+// and flags two factor authentication as enabled in their settings
+func (db *Database) EnableTwoFactor(userId int32, step int64, recoveryCodeHashes []string) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		// only a pending secret may be confirmed and only with a code that was not used yet
+		result := tx.Model(&TwoFactorORM{}).
+			Where("user_id = ? AND enabled_at IS NULL AND last_used_step < ?", userId, step).
+			Updates(map[string]interface{}{"enabled_at": time.Now(), "last_used_step": step})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return helper.ErrInvalidTwoFactorCode
+		}
+
+		if err := tx.Where("user_id = ?", userId).Delete(&RecoveryCodeORM{}).Error; err != nil {
+			return err
+		}
+
+		for _, hash := range recoveryCodeHashes {
+			if err := tx.Create(&RecoveryCodeORM{UserId: userId, CodeHash: hash}).Error; err != nil {
+				return err
+			}
+		}
+
+		return updateUserSettings(tx, userId, map[string]interface{}{"two_factor_enabled": true})
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UseTwoFactorStep marks the time step of a one time password as used. Codes of a time step
+// which was already used, or which precedes it, are rejected
+func (db *Database) UseTwoFactorStep(userId int32, step int64) error {
+	result := db.Engine.Model(&TwoFactorORM{}).
+		Where("user_id = ? AND enabled_at IS NOT NULL AND last_used_step < ?", userId, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		db.Logger.Error(result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		db.Logger.Error(helper.ErrInvalidTwoFactorCode.Error())
+		return helper.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// RedeemRecoveryCode consumes an unused recovery code of a given user
+func (db *Database) RedeemRecoveryCode(userId int32, codeHash string) error {
+	result := db.Engine.Model(&RecoveryCodeORM{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		db.Logger.Error(result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		db.Logger.Error(helper.ErrInvalidTwoFactorCode.Error())
+		return helper.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// DisableTwoFactor removes the two factor secret and recovery codes of a given user
+func (db *Database) DisableTwoFactor(userId int32) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userId).Delete(&TwoFactorORM{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", userId).Delete(&RecoveryCodeORM{}).Error; err != nil {
+			return err
+		}
+
+		return updateUserSettings(tx, userId, map[string]interface{}{"two_factor_enabled": false})
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package postgresql
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+)
+
+func TestUseTwoFactorStepRejectsReplays(t *testing.T) {
+	scripted := &scriptedDriver{}
+	sql.Register("scripted_twofactor", scripted)
+
+	sqlDB, err := sql.Open("scripted_twofactor", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := &Database{Engine: engine, Logger: zap.NewNop()}
+	if err := db.UseTwoFactorStep(1, 37037037); err != nil {
+		t.Fatal(err)
+	}
+
+	// the step is only recorded if it follows the last one used, a replayed code matches no row
+	update := `WHERE (user_id = $3 AND enabled_at IS NOT NULL AND last_used_step < $4)`
+	if executed := strings.Join(scripted.executed, "\n"); !strings.Contains(executed, update) {
+		t.Errorf("expected %q to be executed, got:\n%s", update, executed)
+	}
+}
@@ -2,20 +2,22 @@ package postgresql
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"strings"
+	"time"
 
+	atlasauth "github.com/infobloxopen/atlas-app-toolkit/auth"
 	"github.com/jinzhu/gorm"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
 	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
 )
 
-func (db *Database) CreateUser(user table.UserORM) error {
+// CreateUser persists a user within the account the context is scoped to
+func (db *Database) CreateUser(ctx context.Context, user table.UserORM) error {
 	err := db.Engine.Transaction(func(tx *gorm.DB) error {
 		var foundUser table.UserORM
 
-		ctx := context.TODO()
 		pbUser, err := user.ToPB(ctx)
 		if err != nil {
 			return err
@@ -29,7 +31,9 @@ func (db *Database) CreateUser(user table.UserORM) error {
 		// check if user exists based on email or username fields
 		// Note: email and username fields are unique so if a db entity witholds those respective parameters
 		// we know the user already exists
-		if err = tx.Where("email = ?", pbUser.Email).Or("username = ?", pbUser.UserName).Find(&foundUser).Error; err != nil {
+		// emails and usernames of deleted users remain taken until the users are purged
+		err = tx.Unscoped().Where("email = ?", pbUser.Email).Or("user_name = ?", pbUser.UserName).Find(&foundUser).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
 			return err
 		}
 
@@ -37,8 +41,13 @@ func (db *Database) CreateUser(user table.UserORM) error {
 			return helper.ErrAlreadyExists
 		}
 
-		// save the user to the database
-		if err := tx.Create(user).Error; err != nil {
+		// the email may also be pending confirmation by another user
+		if err = ensureEmailAvailable(tx, pbUser.Email); err != nil {
+			return err
+		}
+
+		// save the user to the database, the generated handler stamps the account id of the context
+		if _, err := table.DefaultCreateUser(ctx, &pbUser, tx); err != nil {
 			return err
 		}
 		return nil
@@ -46,14 +55,23 @@ func (db *Database) CreateUser(user table.UserORM) error {
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
 }
 
-func (db *Database) UpdateUser(user table.UserORM) error {
+// UpdateUser updates every field of a user belonging to the account the context is scoped to
+func (db *Database) UpdateUser(ctx context.Context, user table.UserORM) error {
 	err := db.Engine.Transaction(func(tx *gorm.DB) error {
-		ctx := context.TODO()
+		// the generated handler replaces the subscriptions of the user, hence the stored ones are kept
+		// unless the caller provides them
+		if user.SubscriptionsId == nil {
+			if err := tx.Where("user_id = ?", user.Id).Find(&user.SubscriptionsId).Error; err != nil {
+				return err
+			}
+		}
+
 		pbUser, err := user.ToPB(ctx)
 		if err != nil {
 			return err
@@ -65,7 +83,7 @@ func (db *Database) UpdateUser(user table.UserORM) error {
 		}
 
 		// Updates all fields in a user entity in the database
-		if err = tx.Save(user).Error; err != nil {
+		if _, err = table.DefaultStrictUpdateUser(ctx, &pbUser, tx); err != nil {
 			return err
 		}
 
@@ -74,92 +92,255 @@ func (db *Database) UpdateUser(user table.UserORM) error {
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
 }
 
-func (db *Database) DeleteUser(user table.UserORM) error {
+// PatchUser updates the fields listed by a field mask of a user belonging to the account the context is
+// scoped to and returns the updated user
+func (db *Database) PatchUser(ctx context.Context, user table.UserORM, updateMask *field_mask.FieldMask) (error, *table.UserORM) {
+	var updatedUser table.UserORM
 	err := db.Engine.Transaction(func(tx *gorm.DB) error {
-		var foundUser table.UserORM
-		ctx := context.TODO()
 		pbUser, err := user.ToPB(ctx)
 		if err != nil {
 			return err
 		}
 
-		err = pbUser.Validate()
+		// usernames are unique
+		for _, path := range updateMask.GetPaths() {
+			if path != "UserName" {
+				continue
+			}
+
+			var foundUser table.UserORM
+			err := tx.Unscoped().Where("user_name = ? AND id <> ?", pbUser.UserName, pbUser.Id).First(&foundUser).Error
+			if err == nil {
+				return helper.ErrAlreadyExists
+			}
+			if !gorm.IsRecordNotFoundError(err) {
+				return err
+			}
+		}
+
+		// the generated handler reads the stored user, applies the masked fields and saves it back
+		pbPatched, err := table.DefaultPatchUser(ctx, &pbUser, updateMask, tx)
 		if err != nil {
 			return err
 		}
 
-		// Check if user exists
-		if err := tx.First(&foundUser, user.Id).Error; err != nil {
+		// the transaction is rolled back should the patched user be invalid
+		if err = pbPatched.Validate(); err != nil {
 			return err
 		}
 
-		// user exists in the database hence perform deletion
-		if err = tx.Where("email = ?", user.Email).Delete(&foundUser).Error; err != nil {
+		updatedUser, err = pbPatched.ToORM(ctx)
+		return err
+	})
+
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
+	return nil, &updatedUser
+}
+
+// DeleteUser soft deletes a user belonging to the account the context is scoped to. Deleted users are
+// left out of every query until they are restored or purged
+func (db *Database) DeleteUser(ctx context.Context, user table.UserORM) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		// the generated handlers are scoped to the account of the context and skip deleted users
+		if _, err := table.DefaultReadUser(ctx, &table.User{Id: user.Id}, tx); err != nil {
 			return err
 		}
 
-		return nil
+		// deleted_at is stamped rather than the row being removed
+		return table.DefaultDeleteUser(ctx, &table.User{Id: user.Id}, tx)
 	})
 
 	if err != nil {
 		db.Logger.Error(err.Error())
+		return err
 	}
 
 	return nil
 }
 
-func (db *Database) GetUserById(id int32) (error, *table.UserORM) {
-	var foundUser table.UserORM
+// RestoreUser restores a user of the account the context is scoped to provided they were deleted after
+// a given time
+func (db *Database) RestoreUser(ctx context.Context, id int32, deletedAfter time.Time) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		accountID, err := atlasauth.GetAccountID(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		var foundUser table.UserORM
+		err = tx.Unscoped().Set("gorm:query_option", "FOR UPDATE").
+			Where("id = ? AND account_id = ? AND deleted_at IS NOT NULL", id, accountID).
+			First(&foundUser).Error
+		if err != nil {
+			return err
+		}
+
+		if foundUser.DeletedAt.Before(deletedAfter) {
+			return helper.ErrRestorePeriodElapsed
+		}
 
-	// attempt to obtain a user from the database with this id
-	if err := db.Engine.Where("id = ?", id).First(&foundUser).Error; err != nil {
+		// erased users are gone for good
+		err = tx.Where("user_id = ?", id).First(&ErasureReceiptORM{}).Error
+		if err == nil {
+			return gorm.ErrRecordNotFound
+		}
+		if !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+
+		return tx.Unscoped().Model(&foundUser).Update("deleted_at", nil).Error
+	})
+
+	if err != nil {
 		db.Logger.Error(err.Error())
-		return err, nil
+		return err
 	}
 
-	// Validate all required fields are populated
-	ctx := context.TODO()
-	if err := db.validateUser(foundUser, ctx); err != nil {
+	return nil
+}
+
+// GetDeletedUsers lists the users of every account deleted before a given time, least recently deleted first
+func (db *Database) GetDeletedUsers(deletedBefore time.Time, limit int) (error, []*table.UserORM) {
+	var users []*table.UserORM
+
+	err := db.Engine.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Order("deleted_at").Limit(limit).Find(&users).Error
+	if err != nil {
+		db.Logger.Error(err.Error())
 		return err, nil
 	}
 
-	return nil, &foundUser
+	return nil, users
 }
 
-func (db *Database) GetUserByUsername(username string) (error, *table.UserORM) {
-	var foundUser table.UserORM
+// PurgeUser permanently deletes a deleted user alongside their profile, address, education, experience
+// and social media as well as their subscriptions and roles
+func (db *Database) PurgeUser(id int32) error {
+	err := db.Engine.Transaction(func(tx *gorm.DB) error {
+		tx = tx.Unscoped()
+
+		var foundUser table.UserORM
+		if err := tx.Where("id = ? AND deleted_at IS NOT NULL", id).First(&foundUser).Error; err != nil {
+			return err
+		}
+
+		profile, err := userProfile(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if profile != nil {
+			if err := purgeProfile(tx, profile); err != nil {
+				return err
+			}
+		}
 
-	// attempt to obtain a user from the database with this username
-	if err := db.Engine.Where("username = ?", username).First(&foundUser).Error; err != nil {
+		if err := tx.Where("user_id = ?", id).Delete(&table.SubscriptionsORM{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&MembershipORM{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&DataExportORM{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", id).Delete(&table.UserORM{}).Error
+	})
+
+	if err != nil {
 		db.Logger.Error(err.Error())
-		return err, nil
+		return err
 	}
 
-	// Validate all required fields are populated
-	ctx := context.TODO()
-	if err := db.validateUser(foundUser, ctx); err != nil {
-		return err, nil
+	return nil
+}
+
+// purgeProfile permanently deletes a profile alongside its address, education, experience and social
+// media. Rows are always deleted by id so that a profile missing an association never deletes a whole table
+func purgeProfile(tx *gorm.DB, profile *table.ProfileORM) error {
+	if profile.AddressId != nil && profile.AddressId.Id != 0 {
+		if err := tx.Where("id = ?", profile.AddressId.Id).Delete(&table.AddressORM{}).Error; err != nil {
+			return err
+		}
 	}
 
-	return nil, &foundUser
+	if err := tx.Where("profile_id = ?", profile.Id).Delete(&table.EducationORM{}).Error; err != nil {
+		return err
+	}
+
+	var experienceIds []int32
+	for _, experience := range profile.ExperienceId {
+		experienceIds = append(experienceIds, experience.Id)
+	}
+
+	if err := tx.Model(profile).Association("ExperienceId").Clear().Error; err != nil {
+		return err
+	}
+
+	if len(experienceIds) > 0 {
+		if err := tx.Where("id IN (?)", experienceIds).Delete(&table.ExperienceORM{}).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Where("id = ?", profile.Id).Delete(&table.ProfileORM{}).Error; err != nil {
+		return err
+	}
+
+	if profile.SocialMediaId != nil {
+		if err := tx.Where("id = ?", *profile.SocialMediaId).Delete(&table.SocialMediaORM{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetUserById obtains a user of the account the context is scoped to by id
+func (db *Database) GetUserById(ctx context.Context, id int32) (error, *table.UserORM) {
+	return db.getUser(ctx, "id = ?", id)
 }
 
-func (db *Database) GetUserByEmail(email string) (error, *table.UserORM) {
+// GetUserByUsername obtains a user of the account the context is scoped to by username
+func (db *Database) GetUserByUsername(ctx context.Context, username string) (error, *table.UserORM) {
+	return db.getUser(ctx, "user_name = ?", username)
+}
+
+// GetUserByEmail obtains a user of the account the context is scoped to by email
+func (db *Database) GetUserByEmail(ctx context.Context, email string) (error, *table.UserORM) {
+	return db.getUser(ctx, "email = ?", email)
+}
+
+// getUser obtains the user of the account the context is scoped to matching a condition
+func (db *Database) getUser(ctx context.Context, where string, args ...interface{}) (error, *table.UserORM) {
+	accountID, err := atlasauth.GetAccountID(ctx, nil)
+	if err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
+	}
+
 	var foundUser table.UserORM
 
-	// attempt to obtain a user from the database with this username
-	if err := db.Engine.Where("email = ?", email).First(&foundUser).Error; err != nil {
+	// attempt to obtain a user from the database matching the condition
+	if err := db.Engine.Where("account_id = ?", accountID).Where(where, args...).First(&foundUser).Error; err != nil {
 		db.Logger.Error(err.Error())
 		return err, nil
 	}
 
 	// Validate all required fields are populated
-	ctx := context.TODO()
 	if err := db.validateUser(foundUser, ctx); err != nil {
 		return err, nil
 	}
@@ -167,45 +348,32 @@ func (db *Database) GetUserByEmail(email string) (error, *table.UserORM) {
 	return nil, &foundUser
 }
 
-func (db *Database) GetAllUsers(limit int) (error, []*table.UserORM) {
-	var users []*table.UserORM
-	var user table.UserORM
-
-	// find all users in the users tables but do not breach limit
-	rows, err := db.Engine.Limit(limit).Find(table.UserORM{}).Rows()
+// SearchUsers lists the active users of a given account type within the account the context is scoped
+// to whose name, username or intent contain a query. Users whose username matches the query come first
+func (db *Database) SearchUsers(ctx context.Context, query string, accountType string, limit int) (error, []*table.UserORM) {
+	accountID, err := atlasauth.GetAccountID(ctx, nil)
 	if err != nil {
 		db.Logger.Error(err.Error())
 		return err, nil
 	}
 
-	for rows.Next() {
-		if err := rows.Scan(&user); err != nil {
-			return err, nil
-		}
+	scoped := db.Engine.Where("account_id = ? AND user_account_type = ? AND is_active = ?", accountID, accountType, true)
+	if query = strings.TrimSpace(query); query != "" {
+		pattern := "%" + likeEscaper.Replace(query) + "%"
+		scoped = scoped.Where("first_name ILIKE ? OR last_name ILIKE ? OR (first_name || ' ' || last_name) ILIKE ? "+
+			"OR user_name ILIKE ? OR intent ILIKE ?", pattern, pattern, pattern, pattern, pattern).
+			Order(gorm.Expr("CASE WHEN lower(user_name) = lower(?) THEN 0 WHEN user_name ILIKE ? THEN 1 ELSE 2 END",
+				query, likeEscaper.Replace(query)+"%"))
+	}
 
-		users = append(users, &user)
+	var users []*table.UserORM
+	if err := scoped.Order("id").Limit(limit).Find(&users).Error; err != nil {
+		db.Logger.Error(err.Error())
+		return err, nil
 	}
 
 	return nil, users
 }
 
-func (db Database) GetUserBasedOnParam(param string, query string) (table.UserORM, error) {
-	if param == "" || query == "" {
-		errMsg := fmt.Sprintf("Invalid Argument provided. One of "+
-			"the following params are null Search Param : %s, Query : %s", param, query)
-		return table.UserORM{}, errors.New(errMsg)
-	}
-
-	var user table.UserORM
-	rows, e := db.Engine.Table(UsersTableName).Raw(query, param).Rows()
-	if e != nil {
-		return user, e
-	}
-
-	defer rows.Close()
-	for rows.Next() {
-		_ = db.Engine.ScanRows(rows, &user)
-	}
-
-	return user, e
-}
+// likeEscaper escapes the wildcards of LIKE patterns so that queries are matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
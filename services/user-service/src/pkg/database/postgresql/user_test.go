@@ -0,0 +1,131 @@
+package postgresql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+)
+
+// scriptedResult is the result set returned to queries containing a fragment
+type scriptedResult struct {
+	fragment string
+	columns  []string
+	rows     [][]driver.Value
+}
+
+// scriptedDriver answers queries with scripted result sets and records every statement executed
+type scriptedDriver struct {
+	mu       sync.Mutex
+	results  []scriptedResult
+	executed []string
+}
+
+func (d *scriptedDriver) Open(string) (driver.Conn, error) { return scriptedConn{d}, nil }
+
+func (d *scriptedDriver) record(query string, args []driver.Value) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.executed = append(d.executed, fmt.Sprint(query, args))
+}
+
+type scriptedConn struct{ d *scriptedDriver }
+
+func (c scriptedConn) Prepare(query string) (driver.Stmt, error) {
+	return scriptedStmt{c.d, query}, nil
+}
+func (c scriptedConn) Close() error              { return nil }
+func (c scriptedConn) Begin() (driver.Tx, error) { return c, nil }
+func (c scriptedConn) Commit() error             { return nil }
+func (c scriptedConn) Rollback() error           { return nil }
+
+type scriptedStmt struct {
+	d     *scriptedDriver
+	query string
+}
+
+func (s scriptedStmt) Close() error  { return nil }
+func (s scriptedStmt) NumInput() int { return -1 }
+
+func (s scriptedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.record(s.query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s scriptedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.record(s.query, args)
+	for _, result := range s.d.results {
+		if strings.Contains(s.query, result.fragment) {
+			return &scriptedRows{columns: result.columns, rows: result.rows}, nil
+		}
+	}
+	return &scriptedRows{columns: []string{"id"}}, nil
+}
+
+type scriptedRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *scriptedRows) Columns() []string { return r.columns }
+func (r *scriptedRows) Close() error      { return nil }
+
+func (r *scriptedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestPurgeUserDeletesProfile(t *testing.T) {
+	scripted := &scriptedDriver{results: []scriptedResult{
+		{`SELECT profile_id AS id FROM "users"`, []string{"id"}, [][]driver.Value{{int64(7)}}},
+		{`SELECT address_id AS id FROM "profiles"`, []string{"id"}, [][]driver.Value{{int64(5)}}},
+		{`FROM "users"`, []string{"id"}, [][]driver.Value{{int64(1)}}},
+		{`FROM "profiles"`, []string{"id", "social_media_id"}, [][]driver.Value{{int64(7), int64(9)}}},
+		{`FROM "addresses"`, []string{"id"}, [][]driver.Value{{int64(5)}}},
+		{`FROM "educations"`, []string{"id", "profile_id"}, [][]driver.Value{{int64(3), int64(7)}}},
+		{`FROM "experiences"`, []string{"id", "profile_id"}, [][]driver.Value{{int64(11), int64(7)}}},
+		{`FROM "social_media"`, []string{"id"}, [][]driver.Value{{int64(9)}}},
+	}}
+	sql.Register("scripted_purge", scripted)
+
+	sqlDB, err := sql.Open("scripted_purge", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := &Database{Engine: engine, Logger: zap.NewNop()}
+	if err := db.PurgeUser(1); err != nil {
+		t.Fatal(err)
+	}
+
+	deletions := []string{
+		`DELETE FROM "addresses"  WHERE (id = $1)[5]`,
+		`DELETE FROM "educations"  WHERE (profile_id = $1)[7]`,
+		`DELETE FROM "profile_experiences"`,
+		`DELETE FROM "experiences"  WHERE (id IN ($1))[11]`,
+		`DELETE FROM "profiles"  WHERE (id = $1)[7]`,
+		`DELETE FROM "social_media"  WHERE (id = $1)[9]`,
+		`DELETE FROM "users"  WHERE (id = $1)[1]`,
+	}
+
+	executed := strings.Join(scripted.executed, "\n")
+	for _, deletion := range deletions {
+		if !strings.Contains(executed, deletion) {
+			t.Errorf("expected %q to be executed, got:\n%s", deletion, executed)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package postgresql
+
+import (
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	table "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// ActivateUser activates the account of a given user provided their email still matches the
+// address which was verified
+func (db *Database) ActivateUser(userId int32, email string) error {
+	result := db.Engine.Model(&table.UserORM{}).
+		Where("id = ? AND email = ?", userId, email).
+		Update("is_active", true)
+	if result.Error != nil {
+		db.Logger.Error(result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		db.Logger.Error(helper.ErrInvalidVerificationToken.Error())
+		return helper.ErrInvalidVerificationToken
+	}
+
+	return nil
+}
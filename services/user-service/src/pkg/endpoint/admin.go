@@ -0,0 +1,151 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeUnlockUserEndpoint constructs an Unlock User endpoint wrapping the service.
+func MakeUnlockUserEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	unlockUserEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnlockUserRequest)
+		err = s.UnlockUser(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return UnlockUserResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(unlockUserEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeRestoreUserEndpoint constructs a Restore User endpoint wrapping the service.
+func MakeRestoreUserEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	restoreUserEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RestoreUserRequest)
+		err = s.RestoreUser(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RestoreUserResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(restoreUserEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeImpersonateEndpoint constructs an Impersonate endpoint wrapping the service.
+func MakeImpersonateEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	impersonateEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ImpersonateRequest)
+		token, err := s.Impersonate(ctx, req.UserId)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ImpersonateResponse{Err: err, Token: token}, nil
+	}
+	return WrapMiddlewares(impersonateEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// UnlockUser implements the service interface so that set may be used as a service.
+func (s Set) UnlockUser(ctx context.Context, id int32) (err error) {
+	resp, err := s.UnlockUserEndpoint(ctx, UnlockUserRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	response := resp.(UnlockUserResponse)
+	return response.Err
+}
+
+// RestoreUser implements the service interface so that set may be used as a service.
+func (s Set) RestoreUser(ctx context.Context, id int32) (err error) {
+	resp, err := s.RestoreUserEndpoint(ctx, RestoreUserRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	response := resp.(RestoreUserResponse)
+	return response.Err
+}
+
+// Impersonate implements the service interface so that set may be used as a service.
+func (s Set) Impersonate(ctx context.Context, userId int32) (token auth.TokenPair, err error) {
+	resp, err := s.ImpersonateEndpoint(ctx, ImpersonateRequest{UserId: userId})
+	if err != nil {
+		return token, err
+	}
+	response := resp.(ImpersonateResponse)
+	return response.Token, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = UnlockUserResponse{}
+	_ endpoint.Failer = RestoreUserResponse{}
+	_ endpoint.Failer = ImpersonateResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// UnlockUserRequest collects the request parameters for the UnlockUser method.
+type UnlockUserRequest struct {
+	Id int32
+}
+
+// RestoreUserRequest collects the request parameters for the RestoreUser method.
+type RestoreUserRequest struct {
+	Id int32
+}
+
+// ImpersonateRequest collects the request parameters for the Impersonate method.
+type ImpersonateRequest struct {
+	UserId int32
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// UnlockUserResponse collects the response values for the UnlockUser method.
+type UnlockUserResponse struct {
+	Err error `json:"err"`
+}
+
+// RestoreUserResponse collects the response values for the RestoreUser method.
+type RestoreUserResponse struct {
+	Err error `json:"err"`
+}
+
+// ImpersonateResponse collects the response values for the Impersonate method.
+type ImpersonateResponse struct {
+	Err   error          `json:"err"`
+	Token auth.TokenPair `json:"token"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r UnlockUserResponse) error() error   { return r.Err }
+func (r UnlockUserResponse) Failed() error  { return r.Err }
+func (r RestoreUserResponse) error() error  { return r.Err }
+func (r RestoreUserResponse) Failed() error { return r.Err }
+func (r ImpersonateResponse) error() error  { return r.Err }
+func (r ImpersonateResponse) Failed() error { return r.Err }
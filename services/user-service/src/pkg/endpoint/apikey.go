@@ -0,0 +1,161 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeCreateAPIKeyEndpoint constructs a Create Api Key endpoint wrapping the service.
+func MakeCreateAPIKeyEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	createAPIKeyEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(CreateAPIKeyRequest)
+		key, apiKey, err := s.CreateAPIKey(ctx, req.Name, req.TeamId, req.Scopes, time.Duration(req.ExpiresIn)*time.Second)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return CreateAPIKeyResponse{Err: err, Key: key, APIKey: apiKey}, nil
+	}
+	return WrapMiddlewares(createAPIKeyEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeListAPIKeysEndpoint constructs a List Api Keys endpoint wrapping the service.
+func MakeListAPIKeysEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	listAPIKeysEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ListAPIKeysRequest)
+		keys, err := s.ListAPIKeys(ctx, req.TeamId)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ListAPIKeysResponse{Err: err, Keys: keys}, nil
+	}
+	return WrapMiddlewares(listAPIKeysEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeRevokeAPIKeyEndpoint constructs a Revoke Api Key endpoint wrapping the service.
+func MakeRevokeAPIKeyEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	revokeAPIKeyEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RevokeAPIKeyRequest)
+		err = s.RevokeAPIKey(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RevokeAPIKeyResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(revokeAPIKeyEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// CreateAPIKey implements the service interface so that set may be used as a service.
+func (s Set) CreateAPIKey(ctx context.Context, name string, teamId int32, scopes []string,
+	expiresIn time.Duration) (key string, apiKey database.APIKeyORM, err error) {
+	resp, err := s.CreateAPIKeyEndpoint(ctx, CreateAPIKeyRequest{Name: name, TeamId: teamId,
+		Scopes: scopes, ExpiresIn: int64(expiresIn.Seconds())})
+	if err != nil {
+		return key, apiKey, err
+	}
+	response := resp.(CreateAPIKeyResponse)
+	return response.Key, response.APIKey, response.Err
+}
+
+// ListAPIKeys implements the service interface so that set may be used as a service.
+func (s Set) ListAPIKeys(ctx context.Context, teamId int32) (keys []*database.APIKeyORM, err error) {
+	resp, err := s.ListAPIKeysEndpoint(ctx, ListAPIKeysRequest{TeamId: teamId})
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(ListAPIKeysResponse)
+	return response.Keys, response.Err
+}
+
+// RevokeAPIKey implements the service interface so that set may be used as a service.
+func (s Set) RevokeAPIKey(ctx context.Context, id int32) (err error) {
+	resp, err := s.RevokeAPIKeyEndpoint(ctx, RevokeAPIKeyRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	response := resp.(RevokeAPIKeyResponse)
+	return response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = CreateAPIKeyResponse{}
+	_ endpoint.Failer = ListAPIKeysResponse{}
+	_ endpoint.Failer = RevokeAPIKeyResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// CreateAPIKeyRequest collects the request parameters for the CreateAPIKey method.
+// ExpiresIn is expressed in seconds.
+type CreateAPIKeyRequest struct {
+	Name      string   `json:"name"`
+	TeamId    int32    `json:"team_id"`
+	Scopes    []string `json:"scopes"`
+	ExpiresIn int64    `json:"expires_in"`
+}
+
+// ListAPIKeysRequest collects the request parameters for the ListAPIKeys method.
+type ListAPIKeysRequest struct {
+	TeamId int32
+}
+
+// RevokeAPIKeyRequest collects the request parameters for the RevokeAPIKey method.
+type RevokeAPIKeyRequest struct {
+	Id int32
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// CreateAPIKeyResponse collects the response values for the CreateAPIKey method.
+type CreateAPIKeyResponse struct {
+	Err    error              `json:"err"`
+	Key    string             `json:"api_key"`
+	APIKey database.APIKeyORM `json:"key"`
+}
+
+// ListAPIKeysResponse collects the response values for the ListAPIKeys method.
+type ListAPIKeysResponse struct {
+	Err  error                 `json:"err"`
+	Keys []*database.APIKeyORM `json:"keys"`
+}
+
+// RevokeAPIKeyResponse collects the response values for the RevokeAPIKey method.
+type RevokeAPIKeyResponse struct {
+	Err error `json:"err"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r CreateAPIKeyResponse) error() error  { return r.Err }
+func (r CreateAPIKeyResponse) Failed() error { return r.Err }
+func (r ListAPIKeysResponse) error() error   { return r.Err }
+func (r ListAPIKeysResponse) Failed() error  { return r.Err }
+func (r RevokeAPIKeyResponse) error() error  { return r.Err }
+func (r RevokeAPIKeyResponse) Failed() error { return r.Err }
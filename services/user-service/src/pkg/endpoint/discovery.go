@@ -0,0 +1,98 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeGetOpenIDConfigurationEndpoint constructs an OpenID Configuration endpoint wrapping the service.
+func MakeGetOpenIDConfigurationEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	getOpenIDConfigurationEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		configuration, err := s.GetOpenIDConfiguration(ctx)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return GetOpenIDConfigurationResponse{Err: err, Configuration: configuration}, nil
+	}
+	return WrapMiddlewares(getOpenIDConfigurationEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeGetJSONWebKeySetEndpoint constructs a JSON Web Key Set endpoint wrapping the service.
+func MakeGetJSONWebKeySetEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	getJSONWebKeySetEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		keys, err := s.GetJSONWebKeySet(ctx)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return GetJSONWebKeySetResponse{Err: err, Keys: keys}, nil
+	}
+	return WrapMiddlewares(getJSONWebKeySetEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// GetOpenIDConfiguration implements the service interface so that set may be used as a service.
+func (s Set) GetOpenIDConfiguration(ctx context.Context) (configuration auth.OpenIDConfiguration, err error) {
+	resp, err := s.GetOpenIDConfigurationEndpoint(ctx, nil)
+	if err != nil {
+		return configuration, err
+	}
+	response := resp.(GetOpenIDConfigurationResponse)
+	return response.Configuration, response.Err
+}
+
+// GetJSONWebKeySet implements the service interface so that set may be used as a service.
+func (s Set) GetJSONWebKeySet(ctx context.Context) (keys auth.JSONWebKeySet, err error) {
+	resp, err := s.GetJSONWebKeySetEndpoint(ctx, nil)
+	if err != nil {
+		return keys, err
+	}
+	response := resp.(GetJSONWebKeySetResponse)
+	return response.Keys, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = GetOpenIDConfigurationResponse{}
+	_ endpoint.Failer = GetJSONWebKeySetResponse{}
+)
+
+// ============================== Endpoint Response Definitions ======================
+
+// GetOpenIDConfigurationResponse collects the response values for the GetOpenIDConfiguration method.
+type GetOpenIDConfigurationResponse struct {
+	Err           error                    `json:"err"`
+	Configuration auth.OpenIDConfiguration `json:"configuration"`
+}
+
+// GetJSONWebKeySetResponse collects the response values for the GetJSONWebKeySet method.
+type GetJSONWebKeySetResponse struct {
+	Err  error              `json:"err"`
+	Keys auth.JSONWebKeySet `json:"keys"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r GetOpenIDConfigurationResponse) error() error  { return r.Err }
+func (r GetOpenIDConfigurationResponse) Failed() error { return r.Err }
+func (r GetJSONWebKeySetResponse) error() error        { return r.Err }
+func (r GetJSONWebKeySetResponse) Failed() error       { return r.Err }
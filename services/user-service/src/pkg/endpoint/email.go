@@ -0,0 +1,110 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeChangeEmailEndpoint constructs a Change Email endpoint wrapping the service.
+func MakeChangeEmailEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	changeEmailEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ChangeEmailRequest)
+		err = s.ChangeEmail(ctx, req.Email)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ChangeEmailResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(changeEmailEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeConfirmEmailChangeEndpoint constructs a Confirm Email Change endpoint wrapping the service.
+func MakeConfirmEmailChangeEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	confirmEmailChangeEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ConfirmEmailChangeRequest)
+		err = s.ConfirmEmailChange(ctx, req.Token)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ConfirmEmailChangeResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(confirmEmailChangeEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// ChangeEmail implements the service interface so that set may be used as a service.
+func (s Set) ChangeEmail(ctx context.Context, email string) (err error) {
+	resp, err := s.ChangeEmailEndpoint(ctx, ChangeEmailRequest{Email: email})
+	if err != nil {
+		return err
+	}
+	response := resp.(ChangeEmailResponse)
+	return response.Err
+}
+
+// ConfirmEmailChange implements the service interface so that set may be used as a service.
+func (s Set) ConfirmEmailChange(ctx context.Context, token string) (err error) {
+	resp, err := s.ConfirmEmailChangeEndpoint(ctx, ConfirmEmailChangeRequest{Token: token})
+	if err != nil {
+		return err
+	}
+	response := resp.(ConfirmEmailChangeResponse)
+	return response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ChangeEmailResponse{}
+	_ endpoint.Failer = ConfirmEmailChangeResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// ChangeEmailRequest collects the request parameters for the ChangeEmail method.
+type ChangeEmailRequest struct {
+	Email string `json:"email"`
+}
+
+// ConfirmEmailChangeRequest collects the request parameters for the ConfirmEmailChange method.
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token"`
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// ChangeEmailResponse collects the response values for the ChangeEmail method.
+type ChangeEmailResponse struct {
+	Err error `json:"err"`
+}
+
+// ConfirmEmailChangeResponse collects the response values for the ConfirmEmailChange method.
+type ConfirmEmailChangeResponse struct {
+	Err error `json:"err"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r ChangeEmailResponse) error() error         { return r.Err }
+func (r ChangeEmailResponse) Failed() error        { return r.Err }
+func (r ConfirmEmailChangeResponse) error() error  { return r.Err }
+func (r ConfirmEmailChangeResponse) Failed() error { return r.Err }
@@ -0,0 +1,113 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeEraseUserEndpoint constructs an Erase User endpoint wrapping the service.
+func MakeEraseUserEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	eraseUserEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(EraseUserRequest)
+		receipt, err := s.EraseUser(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return EraseUserResponse{Err: err, Receipt: receipt}, nil
+	}
+	return WrapMiddlewares(eraseUserEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeVerifyErasureReceiptEndpoint constructs a Verify Erasure Receipt endpoint wrapping the service.
+func MakeVerifyErasureReceiptEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	verifyErasureReceiptEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(VerifyErasureReceiptRequest)
+		receipt, err := s.VerifyErasureReceipt(ctx, req.Receipt)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return VerifyErasureReceiptResponse{Err: err, Receipt: receipt}, nil
+	}
+	return WrapMiddlewares(verifyErasureReceiptEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// EraseUser implements the service interface so that set may be used as a service.
+func (s Set) EraseUser(ctx context.Context, id int32) (receipt database.ErasureReceiptORM, err error) {
+	resp, err := s.EraseUserEndpoint(ctx, EraseUserRequest{Id: id})
+	if err != nil {
+		return receipt, err
+	}
+	response := resp.(EraseUserResponse)
+	return response.Receipt, response.Err
+}
+
+// VerifyErasureReceipt implements the service interface so that set may be used as a service.
+func (s Set) VerifyErasureReceipt(ctx context.Context, receipt string) (verified database.ErasureReceiptORM, err error) {
+	resp, err := s.VerifyErasureReceiptEndpoint(ctx, VerifyErasureReceiptRequest{Receipt: receipt})
+	if err != nil {
+		return verified, err
+	}
+	response := resp.(VerifyErasureReceiptResponse)
+	return response.Receipt, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = EraseUserResponse{}
+	_ endpoint.Failer = VerifyErasureReceiptResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// EraseUserRequest collects the request parameters for the EraseUser method.
+type EraseUserRequest struct {
+	Id int32
+}
+
+// VerifyErasureReceiptRequest collects the request parameters for the VerifyErasureReceipt method.
+type VerifyErasureReceiptRequest struct {
+	Receipt string `json:"receipt"`
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// EraseUserResponse collects the response values for the EraseUser method.
+type EraseUserResponse struct {
+	Err     error                      `json:"err"`
+	Receipt database.ErasureReceiptORM `json:"receipt"`
+}
+
+// VerifyErasureReceiptResponse collects the response values for the VerifyErasureReceipt method.
+type VerifyErasureReceiptResponse struct {
+	Err     error                      `json:"err"`
+	Receipt database.ErasureReceiptORM `json:"receipt"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r EraseUserResponse) error() error             { return r.Err }
+func (r EraseUserResponse) Failed() error            { return r.Err }
+func (r VerifyErasureReceiptResponse) error() error  { return r.Err }
+func (r VerifyErasureReceiptResponse) Failed() error { return r.Err }
@@ -0,0 +1,116 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeRequestDataExportEndpoint constructs a Request Data Export endpoint wrapping the service.
+func MakeRequestDataExportEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	requestDataExportEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RequestDataExportRequest)
+		export, err := s.RequestDataExport(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RequestDataExportResponse{Err: err, Export: export}, nil
+	}
+	return WrapMiddlewares(requestDataExportEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeDownloadDataExportEndpoint constructs a Download Data Export endpoint wrapping the service.
+func MakeDownloadDataExportEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	downloadDataExportEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(DownloadDataExportRequest)
+		archive, err := s.DownloadDataExport(ctx, req.Id, req.ExportId)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return DownloadDataExportResponse{Err: err, ExportId: req.ExportId, Archive: archive}, nil
+	}
+	return WrapMiddlewares(downloadDataExportEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// RequestDataExport implements the service interface so that set may be used as a service.
+func (s Set) RequestDataExport(ctx context.Context, id int32) (export database.DataExportORM, err error) {
+	resp, err := s.RequestDataExportEndpoint(ctx, RequestDataExportRequest{Id: id})
+	if err != nil {
+		return export, err
+	}
+	response := resp.(RequestDataExportResponse)
+	return response.Export, response.Err
+}
+
+// DownloadDataExport implements the service interface so that set may be used as a service.
+func (s Set) DownloadDataExport(ctx context.Context, id int32, exportId int32) (archive []byte, err error) {
+	resp, err := s.DownloadDataExportEndpoint(ctx, DownloadDataExportRequest{Id: id, ExportId: exportId})
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(DownloadDataExportResponse)
+	return response.Archive, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = RequestDataExportResponse{}
+	_ endpoint.Failer = DownloadDataExportResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// RequestDataExportRequest collects the request parameters for the RequestDataExport method.
+type RequestDataExportRequest struct {
+	Id int32
+}
+
+// DownloadDataExportRequest collects the request parameters for the DownloadDataExport method.
+type DownloadDataExportRequest struct {
+	Id       int32
+	ExportId int32
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// RequestDataExportResponse collects the response values for the RequestDataExport method.
+type RequestDataExportResponse struct {
+	Err    error                  `json:"err"`
+	Export database.DataExportORM `json:"export"`
+}
+
+// DownloadDataExportResponse collects the response values for the DownloadDataExport method. The
+// archive is written as the raw response body.
+type DownloadDataExportResponse struct {
+	Err      error  `json:"err"`
+	ExportId int32  `json:"-"`
+	Archive  []byte `json:"-"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r RequestDataExportResponse) error() error   { return r.Err }
+func (r RequestDataExportResponse) Failed() error  { return r.Err }
+func (r DownloadDataExportResponse) error() error  { return r.Err }
+func (r DownloadDataExportResponse) Failed() error { return r.Err }
@@ -0,0 +1,234 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeCreateGroupEndpoint constructs a Create Group endpoint wrapping the service.
+func MakeCreateGroupEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	createGroupEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(CreateGroupRequest)
+		err = s.CreateGroup(ctx, req.Group)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return CreateGroupResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(createGroupEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeUpdateGroupEndpoint constructs an Update Group endpoint wrapping the service.
+func MakeUpdateGroupEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	updateGroupEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UpdateGroupRequest)
+		err = s.UpdateGroup(ctx, req.Group)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return UpdateGroupResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(updateGroupEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeDeleteGroupEndpoint constructs a Delete Group endpoint wrapping the service.
+func MakeDeleteGroupEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	deleteGroupEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(DeleteGroupRequest)
+		err = s.DeleteGroup(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return DeleteGroupResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(deleteGroupEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeSetGroupMemberEndpoint constructs a Set Group Member endpoint wrapping the service.
+func MakeSetGroupMemberEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	setGroupMemberEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(SetGroupMemberRequest)
+		err = s.SetGroupMember(ctx, req.GroupId, req.UserId, req.Role)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return SetGroupMemberResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(setGroupMemberEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeRemoveGroupMemberEndpoint constructs a Remove Group Member endpoint wrapping the service.
+func MakeRemoveGroupMemberEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	removeGroupMemberEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RemoveGroupMemberRequest)
+		err = s.RemoveGroupMember(ctx, req.GroupId, req.UserId)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RemoveGroupMemberResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(removeGroupMemberEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// CreateGroup implements the service interface so that set may be used as a service.
+func (s Set) CreateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	resp, err := s.CreateGroupEndpoint(ctx, CreateGroupRequest{Group: group})
+	if err != nil {
+		return err
+	}
+	response := resp.(CreateGroupResponse)
+	return response.Err
+}
+
+// UpdateGroup implements the service interface so that set may be used as a service.
+func (s Set) UpdateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	resp, err := s.UpdateGroupEndpoint(ctx, UpdateGroupRequest{Group: group})
+	if err != nil {
+		return err
+	}
+	response := resp.(UpdateGroupResponse)
+	return response.Err
+}
+
+// DeleteGroup implements the service interface so that set may be used as a service.
+func (s Set) DeleteGroup(ctx context.Context, id int32) (err error) {
+	resp, err := s.DeleteGroupEndpoint(ctx, DeleteGroupRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	response := resp.(DeleteGroupResponse)
+	return response.Err
+}
+
+// SetGroupMember implements the service interface so that set may be used as a service.
+func (s Set) SetGroupMember(ctx context.Context, groupId, userId int32, role string) (err error) {
+	resp, err := s.SetGroupMemberEndpoint(ctx, SetGroupMemberRequest{GroupId: groupId, UserId: userId, Role: role})
+	if err != nil {
+		return err
+	}
+	response := resp.(SetGroupMemberResponse)
+	return response.Err
+}
+
+// RemoveGroupMember implements the service interface so that set may be used as a service.
+func (s Set) RemoveGroupMember(ctx context.Context, groupId, userId int32) (err error) {
+	resp, err := s.RemoveGroupMemberEndpoint(ctx, RemoveGroupMemberRequest{GroupId: groupId, UserId: userId})
+	if err != nil {
+		return err
+	}
+	response := resp.(RemoveGroupMemberResponse)
+	return response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = CreateGroupResponse{}
+	_ endpoint.Failer = UpdateGroupResponse{}
+	_ endpoint.Failer = DeleteGroupResponse{}
+	_ endpoint.Failer = SetGroupMemberResponse{}
+	_ endpoint.Failer = RemoveGroupMemberResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// CreateGroupRequest collects the request parameters for the CreateGroup method.
+type CreateGroupRequest struct {
+	Group user_service.GroupORM `json:"group"`
+}
+
+// UpdateGroupRequest collects the request parameters for the UpdateGroup method.
+type UpdateGroupRequest struct {
+	Group user_service.GroupORM `json:"group"`
+}
+
+// DeleteGroupRequest collects the request parameters for the DeleteGroup method.
+type DeleteGroupRequest struct {
+	Id int32
+}
+
+// SetGroupMemberRequest collects the request parameters for the SetGroupMember method.
+type SetGroupMemberRequest struct {
+	GroupId int32
+	UserId  int32
+	Role    string `json:"role"`
+}
+
+// RemoveGroupMemberRequest collects the request parameters for the RemoveGroupMember method.
+type RemoveGroupMemberRequest struct {
+	GroupId int32
+	UserId  int32
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// CreateGroupResponse collects the response values for the CreateGroup method.
+type CreateGroupResponse struct {
+	Err error `json:"err"`
+}
+
+// UpdateGroupResponse collects the response values for the UpdateGroup method.
+type UpdateGroupResponse struct {
+	Err error `json:"err"`
+}
+
+// DeleteGroupResponse collects the response values for the DeleteGroup method.
+type DeleteGroupResponse struct {
+	Err error `json:"err"`
+}
+
+// SetGroupMemberResponse collects the response values for the SetGroupMember method.
+type SetGroupMemberResponse struct {
+	Err error `json:"err"`
+}
+
+// RemoveGroupMemberResponse collects the response values for the RemoveGroupMember method.
+type RemoveGroupMemberResponse struct {
+	Err error `json:"err"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r CreateGroupResponse) error() error        { return r.Err }
+func (r CreateGroupResponse) Failed() error       { return r.Err }
+func (r UpdateGroupResponse) error() error        { return r.Err }
+func (r UpdateGroupResponse) Failed() error       { return r.Err }
+func (r DeleteGroupResponse) error() error        { return r.Err }
+func (r DeleteGroupResponse) Failed() error       { return r.Err }
+func (r SetGroupMemberResponse) error() error     { return r.Err }
+func (r SetGroupMemberResponse) Failed() error    { return r.Err }
+func (r RemoveGroupMemberResponse) error() error  { return r.Err }
+func (r RemoveGroupMemberResponse) Failed() error { return r.Err }
@@ -0,0 +1,152 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeListUsersEndpoint constructs a List Users endpoint wrapping the service.
+func MakeListUsersEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	listUsersEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ListRequest)
+		users, nextCursor, err := s.ListUsers(ctx, req.Query, req.Cursor)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ListUsersResponse{Err: err, Users: users, NextCursor: nextCursor}, nil
+	}
+	return WrapMiddlewares(listUsersEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeListTeamsEndpoint constructs a List Teams endpoint wrapping the service.
+func MakeListTeamsEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	listTeamsEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ListRequest)
+		teams, nextCursor, err := s.ListTeams(ctx, req.Query, req.Cursor)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ListTeamsResponse{Err: err, Teams: teams, NextCursor: nextCursor}, nil
+	}
+	return WrapMiddlewares(listTeamsEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeListGroupsEndpoint constructs a List Groups endpoint wrapping the service.
+func MakeListGroupsEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	listGroupsEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ListRequest)
+		groups, nextCursor, err := s.ListGroups(ctx, req.Query, req.Cursor)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ListGroupsResponse{Err: err, Groups: groups, NextCursor: nextCursor}, nil
+	}
+	return WrapMiddlewares(listGroupsEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// ListUsers implements the service interface so that set may be used as a service.
+func (s Set) ListUsers(ctx context.Context, query database.ListQuery, cursor string) (users []*user_service.UserORM, nextCursor string, err error) {
+	resp, err := s.ListUsersEndpoint(ctx, ListRequest{Query: query, Cursor: cursor})
+	if err != nil {
+		return nil, "", err
+	}
+	response := resp.(ListUsersResponse)
+	return response.Users, response.NextCursor, response.Err
+}
+
+// ListTeams implements the service interface so that set may be used as a service.
+func (s Set) ListTeams(ctx context.Context, query database.ListQuery, cursor string) (teams []*user_service.TeamORM, nextCursor string, err error) {
+	resp, err := s.ListTeamsEndpoint(ctx, ListRequest{Query: query, Cursor: cursor})
+	if err != nil {
+		return nil, "", err
+	}
+	response := resp.(ListTeamsResponse)
+	return response.Teams, response.NextCursor, response.Err
+}
+
+// ListGroups implements the service interface so that set may be used as a service.
+func (s Set) ListGroups(ctx context.Context, query database.ListQuery, cursor string) (groups []*user_service.GroupORM, nextCursor string, err error) {
+	resp, err := s.ListGroupsEndpoint(ctx, ListRequest{Query: query, Cursor: cursor})
+	if err != nil {
+		return nil, "", err
+	}
+	response := resp.(ListGroupsResponse)
+	return response.Groups, response.NextCursor, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ListUsersResponse{}
+	_ endpoint.Failer = ListTeamsResponse{}
+	_ endpoint.Failer = ListGroupsResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// ListRequest collects the request parameters for the ListUsers, ListTeams and ListGroups methods.
+type ListRequest struct {
+	Query  database.ListQuery
+	Cursor string
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// Listings share an envelope holding the page of records under data alongside the cursor of the next
+// page, omitted on the last page.
+
+// ListUsersResponse collects the response values for the ListUsers method.
+type ListUsersResponse struct {
+	Err        error                   `json:"err"`
+	Users      []*user_service.UserORM `json:"data"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+// ListTeamsResponse collects the response values for the ListTeams method.
+type ListTeamsResponse struct {
+	Err        error                   `json:"err"`
+	Teams      []*user_service.TeamORM `json:"data"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+// ListGroupsResponse collects the response values for the ListGroups method.
+type ListGroupsResponse struct {
+	Err        error                    `json:"err"`
+	Groups     []*user_service.GroupORM `json:"data"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r ListUsersResponse) error() error   { return r.Err }
+func (r ListUsersResponse) Failed() error  { return r.Err }
+func (r ListTeamsResponse) error() error   { return r.Err }
+func (r ListTeamsResponse) Failed() error  { return r.Err }
+func (r ListGroupsResponse) error() error  { return r.Err }
+func (r ListGroupsResponse) Failed() error { return r.Err }
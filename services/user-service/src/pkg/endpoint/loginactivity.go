@@ -0,0 +1,75 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeGetLoginActivityEndpoint constructs a Get Login Activity endpoint wrapping the service.
+func MakeGetLoginActivityEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	getLoginActivityEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(GetLoginActivityRequest)
+		activities, nextPageToken, err := s.GetLoginActivity(ctx, req.Id, req.PageSize, req.PageToken)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return GetLoginActivityResponse{Err: err, Activities: activities, NextPageToken: nextPageToken}, nil
+	}
+	return WrapMiddlewares(getLoginActivityEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// GetLoginActivity implements the service interface so that set may be used as a service.
+func (s Set) GetLoginActivity(ctx context.Context, id int32, pageSize int, pageToken string) (activities []*user_service.LoginActivityORM, nextPageToken string, err error) {
+	resp, err := s.GetLoginActivityEndpoint(ctx, GetLoginActivityRequest{Id: id, PageSize: pageSize, PageToken: pageToken})
+	if err != nil {
+		return nil, "", err
+	}
+	response := resp.(GetLoginActivityResponse)
+	return response.Activities, response.NextPageToken, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = GetLoginActivityResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// GetLoginActivityRequest collects the request parameters for the GetLoginActivity method.
+type GetLoginActivityRequest struct {
+	Id        int32
+	PageSize  int
+	PageToken string
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// GetLoginActivityResponse collects the response values for the GetLoginActivity method.
+type GetLoginActivityResponse struct {
+	Err           error                            `json:"err"`
+	Activities    []*user_service.LoginActivityORM `json:"activities"`
+	NextPageToken string                           `json:"next_page_token,omitempty"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r GetLoginActivityResponse) error() error  { return r.Err }
+func (r GetLoginActivityResponse) Failed() error { return r.Err }
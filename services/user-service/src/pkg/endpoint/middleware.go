@@ -8,6 +8,9 @@ import (
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/metrics"
 	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
 )
 
 // InstrumentingMiddleware returns an endpoint middleware that records
@@ -42,3 +45,23 @@ func LoggingMiddleware(logger *zap.Logger) endpoint.Middleware {
 		}
 	}
 }
+
+// AuthMiddleware returns an endpoint middleware that authenticates the caller, stores the
+// resulting principal in the request context, and ensures the principal was granted the
+// scope required by the wrapped endpoint.
+func AuthMiddleware(authenticator auth.Authenticator, scope string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			principal, err := authenticator.Authenticate(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if !principal.HasScope(scope) {
+				return nil, helper.ErrForbidden
+			}
+
+			return next(auth.NewContext(ctx, principal), request)
+		}
+	}
+}
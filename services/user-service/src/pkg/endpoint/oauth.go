@@ -0,0 +1,152 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeRegisterOAuthClientEndpoint constructs a Register OAuth Client endpoint wrapping the service.
+func MakeRegisterOAuthClientEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	registerOAuthClientEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RegisterOAuthClientRequest)
+		secret, client, err := s.RegisterOAuthClient(ctx, req.Name, req.RedirectURIs, req.Scopes, req.Public)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RegisterOAuthClientResponse{Err: err, ClientSecret: secret, Client: client}, nil
+	}
+	return WrapMiddlewares(registerOAuthClientEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeAuthorizeEndpoint constructs an Authorize endpoint wrapping the service.
+func MakeAuthorizeEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	authorizeEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(AuthorizeRequest)
+		redirectURI, err := s.Authorize(ctx, req.Request, req.GrantConsent)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return AuthorizeResponse{Err: err, RedirectURI: redirectURI}, nil
+	}
+	return WrapMiddlewares(authorizeEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeExchangeTokenEndpoint constructs an Exchange Token endpoint wrapping the service.
+func MakeExchangeTokenEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	exchangeTokenEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ExchangeTokenRequest)
+		token, err := s.ExchangeToken(ctx, req.Request)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return TokenResponse{Err: err, Token: token}, nil
+	}
+	return WrapMiddlewares(exchangeTokenEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// RegisterOAuthClient implements the service interface so that set may be used as a service.
+func (s Set) RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes []string,
+	public bool) (secret string, client database.OAuthClientORM, err error) {
+	resp, err := s.RegisterOAuthClientEndpoint(ctx, RegisterOAuthClientRequest{Name: name,
+		RedirectURIs: redirectURIs, Scopes: scopes, Public: public})
+	if err != nil {
+		return secret, client, err
+	}
+	response := resp.(RegisterOAuthClientResponse)
+	return response.ClientSecret, response.Client, response.Err
+}
+
+// Authorize implements the service interface so that set may be used as a service.
+func (s Set) Authorize(ctx context.Context, req auth.AuthorizationRequest, grantConsent bool) (redirectURI string, err error) {
+	resp, err := s.AuthorizeEndpoint(ctx, AuthorizeRequest{Request: req, GrantConsent: grantConsent})
+	if err != nil {
+		return "", err
+	}
+	response := resp.(AuthorizeResponse)
+	return response.RedirectURI, response.Err
+}
+
+// ExchangeToken implements the service interface so that set may be used as a service.
+func (s Set) ExchangeToken(ctx context.Context, req auth.TokenRequest) (token auth.TokenPair, err error) {
+	resp, err := s.ExchangeTokenEndpoint(ctx, ExchangeTokenRequest{Request: req})
+	if err != nil {
+		return token, err
+	}
+	response := resp.(TokenResponse)
+	return response.Token, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = RegisterOAuthClientResponse{}
+	_ endpoint.Failer = AuthorizeResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// RegisterOAuthClientRequest collects the request parameters for the RegisterOAuthClient method.
+type RegisterOAuthClientRequest struct {
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+	Public       bool     `json:"public"`
+}
+
+// AuthorizeRequest collects the request parameters for the Authorize method.
+type AuthorizeRequest struct {
+	Request      auth.AuthorizationRequest
+	GrantConsent bool
+}
+
+// ExchangeTokenRequest collects the request parameters for the ExchangeToken method.
+type ExchangeTokenRequest struct {
+	Request auth.TokenRequest
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// RegisterOAuthClientResponse collects the response values for the RegisterOAuthClient method.
+type RegisterOAuthClientResponse struct {
+	Err          error                   `json:"err"`
+	ClientSecret string                  `json:"client_secret,omitempty"`
+	Client       database.OAuthClientORM `json:"client"`
+}
+
+// AuthorizeResponse collects the response values for the Authorize method.
+type AuthorizeResponse struct {
+	Err         error  `json:"err"`
+	RedirectURI string `json:"redirect_uri"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r RegisterOAuthClientResponse) error() error  { return r.Err }
+func (r RegisterOAuthClientResponse) Failed() error { return r.Err }
+func (r AuthorizeResponse) error() error            { return r.Err }
+func (r AuthorizeResponse) Failed() error           { return r.Err }
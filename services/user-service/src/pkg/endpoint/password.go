@@ -0,0 +1,156 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeForgotPasswordEndpoint constructs a Forgot Password endpoint wrapping the service.
+func MakeForgotPasswordEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	forgotPasswordEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ForgotPasswordRequest)
+		err = s.ForgotPassword(ctx, req.Email)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ForgotPasswordResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(forgotPasswordEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeResetPasswordEndpoint constructs a Reset Password endpoint wrapping the service.
+func MakeResetPasswordEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	resetPasswordEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ResetPasswordRequest)
+		err = s.ResetPassword(ctx, req.Token, req.Password, req.PasswordConfirmed)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ResetPasswordResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(resetPasswordEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeChangePasswordEndpoint constructs a Change Password endpoint wrapping the service.
+func MakeChangePasswordEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	changePasswordEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ChangePasswordRequest)
+		err = s.ChangePassword(ctx, req.CurrentPassword, req.Password, req.PasswordConfirmed)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ChangePasswordResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(changePasswordEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// ForgotPassword implements the service interface so that set may be used as a service.
+func (s Set) ForgotPassword(ctx context.Context, email string) (err error) {
+	resp, err := s.ForgotPasswordEndpoint(ctx, ForgotPasswordRequest{Email: email})
+	if err != nil {
+		return err
+	}
+	response := resp.(ForgotPasswordResponse)
+	return response.Err
+}
+
+// ResetPassword implements the service interface so that set may be used as a service.
+func (s Set) ResetPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	resp, err := s.ResetPasswordEndpoint(ctx, ResetPasswordRequest{Token: token, Password: password,
+		PasswordConfirmed: passwordConfirmed})
+	if err != nil {
+		return err
+	}
+	response := resp.(ResetPasswordResponse)
+	return response.Err
+}
+
+// ChangePassword implements the service interface so that set may be used as a service.
+func (s Set) ChangePassword(ctx context.Context, currentPassword, password, passwordConfirmed string) (err error) {
+	resp, err := s.ChangePasswordEndpoint(ctx, ChangePasswordRequest{CurrentPassword: currentPassword,
+		Password: password, PasswordConfirmed: passwordConfirmed})
+	if err != nil {
+		return err
+	}
+	response := resp.(ChangePasswordResponse)
+	return response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ForgotPasswordResponse{}
+	_ endpoint.Failer = ResetPasswordResponse{}
+	_ endpoint.Failer = ChangePasswordResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// ForgotPasswordRequest collects the request parameters for the ForgotPassword method.
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// ResetPasswordRequest collects the request parameters for the ResetPassword method.
+type ResetPasswordRequest struct {
+	Token             string `json:"token"`
+	Password          string `json:"password"`
+	PasswordConfirmed string `json:"password_confirmed"`
+}
+
+// ChangePasswordRequest collects the request parameters for the ChangePassword method.
+type ChangePasswordRequest struct {
+	CurrentPassword   string `json:"current_password"`
+	Password          string `json:"password"`
+	PasswordConfirmed string `json:"password_confirmed"`
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// ForgotPasswordResponse collects the response values for the ForgotPassword method.
+type ForgotPasswordResponse struct {
+	Err error `json:"err"`
+}
+
+// ResetPasswordResponse collects the response values for the ResetPassword method.
+type ResetPasswordResponse struct {
+	Err error `json:"err"`
+}
+
+// ChangePasswordResponse collects the response values for the ChangePassword method.
+type ChangePasswordResponse struct {
+	Err error `json:"err"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r ForgotPasswordResponse) error() error  { return r.Err }
+func (r ForgotPasswordResponse) Failed() error { return r.Err }
+func (r ResetPasswordResponse) error() error   { return r.Err }
+func (r ResetPasswordResponse) Failed() error  { return r.Err }
+func (r ChangePasswordResponse) error() error  { return r.Err }
+func (r ChangePasswordResponse) Failed() error { return r.Err }
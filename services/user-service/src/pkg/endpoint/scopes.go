@@ -0,0 +1,63 @@
+package endpoint
+
+import (
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+)
+
+// requiredScopes maps operation names to the scope a principal must be granted in order
+// to invoke them. Operations absent from this map are public.
+var requiredScopes = map[string]string{
+	"UpdateUser":        auth.ScopeWrite,
+	"DeleteUser":        auth.ScopeWrite,
+	"GetUserById":       auth.ScopeRead,
+	"GetUserByUsername": auth.ScopeRead,
+	"GetUserByEmail":    auth.ScopeRead,
+	"CreateAPIKey":      auth.ScopeWrite,
+	"ListAPIKeys":       auth.ScopeRead,
+	"RevokeAPIKey":      auth.ScopeWrite,
+
+	"RegisterOAuthClient": auth.ScopeWrite,
+	"Authorize":           auth.ScopeWrite,
+
+	"ChangeEmail":    auth.ScopeWrite,
+	"ChangePassword": auth.ScopeWrite,
+
+	"EnrollTwoFactor":  auth.ScopeWrite,
+	"ConfirmTwoFactor": auth.ScopeWrite,
+	"DisableTwoFactor": auth.ScopeWrite,
+
+	"UnlockUser":  auth.ScopeAdmin,
+	"RestoreUser": auth.ScopeAdmin,
+	"Impersonate": auth.ScopeAdmin,
+
+	"GetLoginActivity": auth.ScopeRead,
+
+	"RequestDataExport":  auth.ScopeWrite,
+	"DownloadDataExport": auth.ScopeRead,
+
+	"EraseUser": auth.ScopeWrite,
+
+	"ListUsers":  auth.ScopeRead,
+	"ListTeams":  auth.ScopeRead,
+	"ListGroups": auth.ScopeRead,
+
+	"GetUsersByType": auth.ScopeRead,
+
+	"ListSessions":      auth.ScopeRead,
+	"RevokeSession":     auth.ScopeWrite,
+	"RevokeAllSessions": auth.ScopeWrite,
+
+	"CreateTeam":       auth.ScopeWrite,
+	"UpdateTeam":       auth.ScopeWrite,
+	"DeleteTeam":       auth.ScopeWrite,
+	"SetTeamMember":    auth.ScopeWrite,
+	"RemoveTeamMember": auth.ScopeWrite,
+
+	"ChangeTeamCredentials": auth.ScopeWrite,
+
+	"CreateGroup":       auth.ScopeWrite,
+	"UpdateGroup":       auth.ScopeWrite,
+	"DeleteGroup":       auth.ScopeWrite,
+	"SetGroupMember":    auth.ScopeWrite,
+	"RemoveGroupMember": auth.ScopeWrite,
+}
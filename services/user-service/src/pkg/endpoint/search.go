@@ -0,0 +1,75 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeGetUsersByTypeEndpoint constructs a Get Users By Type endpoint wrapping the service.
+func MakeGetUsersByTypeEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	getUsersByTypeEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(GetUsersByTypeRequest)
+		users, err := s.GetUsersByType(ctx, req.QueryMessage, req.MaxResults, req.AccountType)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return GetUsersByTypeResponse{Err: err, Users: users}, nil
+	}
+	return WrapMiddlewares(getUsersByTypeEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// GetUsersByType implements the service interface so that set may be used as a service.
+func (s Set) GetUsersByType(ctx context.Context, query string, maxResults int32, accountType user_service.AccountType) (users []*user_service.UserORM, err error) {
+	resp, err := s.GetUsersByTypeEndpoint(ctx, GetUsersByTypeRequest{QueryMessage: query, MaxResults: maxResults, AccountType: accountType})
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(GetUsersByTypeResponse)
+	return response.Users, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = GetUsersByTypeResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// GetUsersByTypeRequest collects the request parameters for the GetUsersByType method. It mirrors the
+// GetUsersSearchRequest message of the UsersService.
+type GetUsersByTypeRequest struct {
+	QueryMessage string
+	MaxResults   int32
+	AccountType  user_service.AccountType
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// GetUsersByTypeResponse collects the response values for the GetUsersByType method.
+type GetUsersByTypeResponse struct {
+	Err   error                   `json:"err"`
+	Users []*user_service.UserORM `json:"data"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r GetUsersByTypeResponse) error() error  { return r.Err }
+func (r GetUsersByTypeResponse) Failed() error { return r.Err }
@@ -0,0 +1,132 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeListSessionsEndpoint constructs a List Sessions endpoint wrapping the service.
+func MakeListSessionsEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	listSessionsEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		sessions, err := s.ListSessions(ctx)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ListSessionsResponse{Err: err, Sessions: sessions}, nil
+	}
+	return WrapMiddlewares(listSessionsEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeRevokeSessionEndpoint constructs a Revoke Session endpoint wrapping the service.
+func MakeRevokeSessionEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	revokeSessionEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RevokeSessionRequest)
+		err = s.RevokeSession(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RevokeSessionResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(revokeSessionEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeRevokeAllSessionsEndpoint constructs a Revoke All Sessions endpoint wrapping the service.
+func MakeRevokeAllSessionsEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	revokeAllSessionsEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		err = s.RevokeAllSessions(ctx)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RevokeSessionResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(revokeAllSessionsEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// ListSessions implements the service interface so that set may be used as a service.
+func (s Set) ListSessions(ctx context.Context) (sessions []*database.SessionORM, err error) {
+	resp, err := s.ListSessionsEndpoint(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(ListSessionsResponse)
+	return response.Sessions, response.Err
+}
+
+// RevokeSession implements the service interface so that set may be used as a service.
+func (s Set) RevokeSession(ctx context.Context, id int32) (err error) {
+	resp, err := s.RevokeSessionEndpoint(ctx, RevokeSessionRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	response := resp.(RevokeSessionResponse)
+	return response.Err
+}
+
+// RevokeAllSessions implements the service interface so that set may be used as a service.
+func (s Set) RevokeAllSessions(ctx context.Context) (err error) {
+	resp, err := s.RevokeAllSessionsEndpoint(ctx, nil)
+	if err != nil {
+		return err
+	}
+	response := resp.(RevokeSessionResponse)
+	return response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ListSessionsResponse{}
+	_ endpoint.Failer = RevokeSessionResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// RevokeSessionRequest collects the request parameters for the RevokeSession method.
+type RevokeSessionRequest struct {
+	Id int32
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// ListSessionsResponse collects the response values for the ListSessions method.
+type ListSessionsResponse struct {
+	Err      error                  `json:"err"`
+	Sessions []*database.SessionORM `json:"sessions"`
+}
+
+// RevokeSessionResponse collects the response values for the RevokeSession and RevokeAllSessions methods.
+type RevokeSessionResponse struct {
+	Err error `json:"err"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r ListSessionsResponse) error() error   { return r.Err }
+func (r ListSessionsResponse) Failed() error  { return r.Err }
+func (r RevokeSessionResponse) error() error  { return r.Err }
+func (r RevokeSessionResponse) Failed() error { return r.Err }
@@ -9,7 +9,9 @@ import (
 	"github.com/sony/gobreaker"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
+	"google.golang.org/genproto/protobuf/field_mask"
 
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
 	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
 	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
 
@@ -41,36 +43,172 @@ import (
 // into an Endpoints, and return it to the caller as a Service.
 type Set struct {
 	CreateUserEndpoint        endpoint.Endpoint
+	UpdateUserEndpoint        endpoint.Endpoint
+	DeleteUserEndpoint        endpoint.Endpoint
 	GetUserByIdEndpoint       endpoint.Endpoint
 	GetUserByUsernameEndpoint endpoint.Endpoint
 	GetUserByEmailEndpoint    endpoint.Endpoint
 	LoginEndpoint             endpoint.Endpoint
+	RefreshTokenEndpoint      endpoint.Endpoint
+	LogOutEndpoint            endpoint.Endpoint
+	CreateAPIKeyEndpoint      endpoint.Endpoint
+	ListAPIKeysEndpoint       endpoint.Endpoint
+	RevokeAPIKeyEndpoint      endpoint.Endpoint
+
+	RegisterOAuthClientEndpoint endpoint.Endpoint
+	AuthorizeEndpoint           endpoint.Endpoint
+	ExchangeTokenEndpoint       endpoint.Endpoint
+
+	GetOpenIDConfigurationEndpoint endpoint.Endpoint
+	GetJSONWebKeySetEndpoint       endpoint.Endpoint
+
+	ForgotPasswordEndpoint endpoint.Endpoint
+	ResetPasswordEndpoint  endpoint.Endpoint
+	ChangePasswordEndpoint endpoint.Endpoint
+
+	ChangeEmailEndpoint        endpoint.Endpoint
+	ConfirmEmailChangeEndpoint endpoint.Endpoint
+
+	VerifyEmailEndpoint        endpoint.Endpoint
+	ResendVerificationEndpoint endpoint.Endpoint
+
+	EnrollTwoFactorEndpoint  endpoint.Endpoint
+	ConfirmTwoFactorEndpoint endpoint.Endpoint
+	DisableTwoFactorEndpoint endpoint.Endpoint
+	VerifyTwoFactorEndpoint  endpoint.Endpoint
+
+	UnlockUserEndpoint  endpoint.Endpoint
+	RestoreUserEndpoint endpoint.Endpoint
+	ImpersonateEndpoint endpoint.Endpoint
+
+	GetLoginActivityEndpoint endpoint.Endpoint
+
+	RequestDataExportEndpoint  endpoint.Endpoint
+	DownloadDataExportEndpoint endpoint.Endpoint
+
+	EraseUserEndpoint            endpoint.Endpoint
+	VerifyErasureReceiptEndpoint endpoint.Endpoint
+
+	ListUsersEndpoint  endpoint.Endpoint
+	ListTeamsEndpoint  endpoint.Endpoint
+	ListGroupsEndpoint endpoint.Endpoint
+
+	GetUsersByTypeEndpoint endpoint.Endpoint
+
+	ListSessionsEndpoint      endpoint.Endpoint
+	RevokeSessionEndpoint     endpoint.Endpoint
+	RevokeAllSessionsEndpoint endpoint.Endpoint
+
+	CreateTeamEndpoint         endpoint.Endpoint
+	UpdateTeamEndpoint         endpoint.Endpoint
+	DeleteTeamEndpoint         endpoint.Endpoint
+	SetTeamMemberEndpoint      endpoint.Endpoint
+	RemoveTeamMemberEndpoint   endpoint.Endpoint
+	TeamLoginEndpoint          endpoint.Endpoint
+	ForgotTeamPasswordEndpoint endpoint.Endpoint
+	ResetTeamPasswordEndpoint  endpoint.Endpoint
+
+	ChangeTeamCredentialsEndpoint endpoint.Endpoint
+
+	CreateGroupEndpoint       endpoint.Endpoint
+	UpdateGroupEndpoint       endpoint.Endpoint
+	DeleteGroupEndpoint       endpoint.Endpoint
+	SetGroupMemberEndpoint    endpoint.Endpoint
+	RemoveGroupMemberEndpoint endpoint.Endpoint
 }
 
 // New returns a Set that wraps the provided server, and wires in all of the
 // expected endpoint middlewares via the various parameters.
-func New(svc service.Service, logger *zap.Logger, duration metrics.Histogram, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) Set {
-	return MakeServerEndpoints(svc, logger, duration, otTracer, zipkinTracer)
+func New(svc service.Service, authenticator auth.Authenticator, logger *zap.Logger, duration metrics.Histogram, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) Set {
+	return MakeServerEndpoints(svc, authenticator, logger, duration, otTracer, zipkinTracer)
 }
 
 // MakeServerEndpoints returns an Endpoints struct where each endpoint invokes
 // the corresponding method on the provided service.
-func MakeServerEndpoints(s service.Service, logger *zap.Logger,
+func MakeServerEndpoints(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer) Set {
 	return Set{
-		CreateUserEndpoint:        MakeCreateUserEndpoint(s, logger, duration, otTracer, zipkinTracer, "CreateUser"),
-		GetUserByIdEndpoint:       MakeGetUserByIdEndpoint(s, logger, duration, otTracer, zipkinTracer, "GetUserById"),
-		GetUserByUsernameEndpoint: MakeGetUserByUsernameEndpoint(s, logger, duration, otTracer, zipkinTracer, "GetUserByUsername"),
-		GetUserByEmailEndpoint:    MakeGetUserByEmailEndpoint(s, logger, duration, otTracer, zipkinTracer, "GetUserByEmail"),
-		LoginEndpoint:             MakeLoginEndpoint(s, logger, duration, otTracer, zipkinTracer, "Login"),
+		CreateUserEndpoint:        MakeCreateUserEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "CreateUser"),
+		UpdateUserEndpoint:        MakeUpdateUserEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "UpdateUser"),
+		DeleteUserEndpoint:        MakeDeleteUserEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "DeleteUser"),
+		GetUserByIdEndpoint:       MakeGetUserByIdEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "GetUserById"),
+		GetUserByUsernameEndpoint: MakeGetUserByUsernameEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "GetUserByUsername"),
+		GetUserByEmailEndpoint:    MakeGetUserByEmailEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "GetUserByEmail"),
+		LoginEndpoint:             MakeLoginEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "Login"),
+		RefreshTokenEndpoint:      MakeRefreshTokenEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RefreshToken"),
+		LogOutEndpoint:            MakeLogOutEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "LogOut"),
+		CreateAPIKeyEndpoint:      MakeCreateAPIKeyEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "CreateAPIKey"),
+		ListAPIKeysEndpoint:       MakeListAPIKeysEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ListAPIKeys"),
+		RevokeAPIKeyEndpoint:      MakeRevokeAPIKeyEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RevokeAPIKey"),
+
+		RegisterOAuthClientEndpoint: MakeRegisterOAuthClientEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RegisterOAuthClient"),
+		AuthorizeEndpoint:           MakeAuthorizeEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "Authorize"),
+		ExchangeTokenEndpoint:       MakeExchangeTokenEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ExchangeToken"),
+
+		GetOpenIDConfigurationEndpoint: MakeGetOpenIDConfigurationEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "GetOpenIDConfiguration"),
+		GetJSONWebKeySetEndpoint:       MakeGetJSONWebKeySetEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "GetJSONWebKeySet"),
+
+		ForgotPasswordEndpoint: MakeForgotPasswordEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ForgotPassword"),
+		ResetPasswordEndpoint:  MakeResetPasswordEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ResetPassword"),
+		ChangePasswordEndpoint: MakeChangePasswordEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ChangePassword"),
+
+		ChangeEmailEndpoint:        MakeChangeEmailEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ChangeEmail"),
+		ConfirmEmailChangeEndpoint: MakeConfirmEmailChangeEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ConfirmEmailChange"),
+
+		VerifyEmailEndpoint:        MakeVerifyEmailEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "VerifyEmail"),
+		ResendVerificationEndpoint: MakeResendVerificationEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ResendVerification"),
+
+		EnrollTwoFactorEndpoint:  MakeEnrollTwoFactorEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "EnrollTwoFactor"),
+		ConfirmTwoFactorEndpoint: MakeConfirmTwoFactorEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ConfirmTwoFactor"),
+		DisableTwoFactorEndpoint: MakeDisableTwoFactorEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "DisableTwoFactor"),
+		VerifyTwoFactorEndpoint:  MakeVerifyTwoFactorEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "VerifyTwoFactor"),
+
+		UnlockUserEndpoint:  MakeUnlockUserEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "UnlockUser"),
+		RestoreUserEndpoint: MakeRestoreUserEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RestoreUser"),
+		ImpersonateEndpoint: MakeImpersonateEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "Impersonate"),
+
+		GetLoginActivityEndpoint: MakeGetLoginActivityEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "GetLoginActivity"),
+
+		RequestDataExportEndpoint:  MakeRequestDataExportEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RequestDataExport"),
+		DownloadDataExportEndpoint: MakeDownloadDataExportEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "DownloadDataExport"),
+
+		EraseUserEndpoint:            MakeEraseUserEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "EraseUser"),
+		VerifyErasureReceiptEndpoint: MakeVerifyErasureReceiptEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "VerifyErasureReceipt"),
+
+		ListUsersEndpoint:  MakeListUsersEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ListUsers"),
+		ListTeamsEndpoint:  MakeListTeamsEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ListTeams"),
+		ListGroupsEndpoint: MakeListGroupsEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ListGroups"),
+
+		GetUsersByTypeEndpoint: MakeGetUsersByTypeEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "GetUsersByType"),
+
+		ListSessionsEndpoint:      MakeListSessionsEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ListSessions"),
+		RevokeSessionEndpoint:     MakeRevokeSessionEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RevokeSession"),
+		RevokeAllSessionsEndpoint: MakeRevokeAllSessionsEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RevokeAllSessions"),
+
+		CreateTeamEndpoint:         MakeCreateTeamEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "CreateTeam"),
+		UpdateTeamEndpoint:         MakeUpdateTeamEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "UpdateTeam"),
+		DeleteTeamEndpoint:         MakeDeleteTeamEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "DeleteTeam"),
+		SetTeamMemberEndpoint:      MakeSetTeamMemberEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "SetTeamMember"),
+		RemoveTeamMemberEndpoint:   MakeRemoveTeamMemberEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RemoveTeamMember"),
+		TeamLoginEndpoint:          MakeTeamLoginEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "TeamLogin"),
+		ForgotTeamPasswordEndpoint: MakeForgotTeamPasswordEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ForgotTeamPassword"),
+		ResetTeamPasswordEndpoint:  MakeResetTeamPasswordEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ResetTeamPassword"),
+
+		ChangeTeamCredentialsEndpoint: MakeChangeTeamCredentialsEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "ChangeTeamCredentials"),
+
+		CreateGroupEndpoint:       MakeCreateGroupEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "CreateGroup"),
+		UpdateGroupEndpoint:       MakeUpdateGroupEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "UpdateGroup"),
+		DeleteGroupEndpoint:       MakeDeleteGroupEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "DeleteGroup"),
+		SetGroupMemberEndpoint:    MakeSetGroupMemberEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "SetGroupMember"),
+		RemoveGroupMemberEndpoint: MakeRemoveGroupMemberEndpoint(s, authenticator, logger, duration, otTracer, zipkinTracer, "RemoveGroupMember"),
 	}
 }
 
 // ============================== Endpoint Definitions ======================
 
 // MakeCreateUserEndpoint constructs a Create User endpoint wrapping the service.
-func MakeCreateUserEndpoint(s service.Service, logger *zap.Logger,
+func MakeCreateUserEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
 
@@ -83,12 +221,46 @@ func MakeCreateUserEndpoint(s service.Service, logger *zap.Logger,
 		}
 		return CreateUserResponse{Err: err}, nil
 	}
-	return WrapMiddlewares(createUserEndpoint, logger,
+	return WrapMiddlewares(createUserEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeUpdateUserEndpoint constructs an Update User endpoint wrapping the service.
+func MakeUpdateUserEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	updateUserEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UpdateUserRequest)
+		user, err := s.UpdateUser(ctx, req.User, req.UpdateMask)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return UpdateUserResponse{Err: err, User: user}, nil
+	}
+	return WrapMiddlewares(updateUserEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeDeleteUserEndpoint constructs a Delete User endpoint wrapping the service.
+func MakeDeleteUserEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	deleteUserEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(DeleteUserRequest)
+		err = s.DeleteUser(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return DeleteUserResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(deleteUserEndpoint, authenticator, logger,
 		duration, otTracer, zipkinTracer, operationName)
 }
 
 // MakeGetUserByIdEndpoint constructs a Get User By ID endpoint wrapping the service.
-func MakeGetUserByIdEndpoint(s service.Service, logger *zap.Logger,
+func MakeGetUserByIdEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
 
@@ -101,12 +273,12 @@ func MakeGetUserByIdEndpoint(s service.Service, logger *zap.Logger,
 		}
 		return GetUserResponse{Err: err, User: user}, nil
 	}
-	return WrapMiddlewares(getUserByIdEndpoint, logger,
+	return WrapMiddlewares(getUserByIdEndpoint, authenticator, logger,
 		duration, otTracer, zipkinTracer, operationName)
 }
 
 // MakeGetUserByUsernameEndpoint constructs a Get User By Username endpoint wrapping the service.
-func MakeGetUserByUsernameEndpoint(s service.Service, logger *zap.Logger,
+func MakeGetUserByUsernameEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
 
@@ -119,12 +291,12 @@ func MakeGetUserByUsernameEndpoint(s service.Service, logger *zap.Logger,
 		}
 		return GetUserResponse{Err: err, User: user}, nil
 	}
-	return WrapMiddlewares(getUserByUsernameEndpoint, logger,
+	return WrapMiddlewares(getUserByUsernameEndpoint, authenticator, logger,
 		duration, otTracer, zipkinTracer, operationName)
 }
 
 // MakeGetUserByEmailEndpoint constructs a Get User By Email endpoint wrapping the service.
-func MakeGetUserByEmailEndpoint(s service.Service, logger *zap.Logger,
+func MakeGetUserByEmailEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
 
@@ -137,25 +309,59 @@ func MakeGetUserByEmailEndpoint(s service.Service, logger *zap.Logger,
 		}
 		return GetUserResponse{Err: err, User: user}, nil
 	}
-	return WrapMiddlewares(getUserByEmailEndpoint, logger,
+	return WrapMiddlewares(getUserByEmailEndpoint, authenticator, logger,
 		duration, otTracer, zipkinTracer, operationName)
 }
 
 // MakeLoginEndpoint constructs a Log In endpoint wrapping the service.
-func MakeLoginEndpoint(s service.Service, logger *zap.Logger,
+func MakeLoginEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
 
 	loginEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(LoginRequest)
-		logger.Info("User", zap.Any("attempting to log in", request))
-		user, err := s.LogIn(ctx, req.Username, req.Password)
+		logger.Info("User", zap.String("attempting to log in", req.Username))
+		user, token, challenge, err := s.LogIn(ctx, req.Username, req.Password)
 		if err != nil {
 			logger.Error(err.Error())
 		}
-		return GetUserResponse{Err: err, User: user}, nil
+		return LoginResponse{Err: err, User: user, Token: token, Challenge: challenge}, nil
 	}
-	return WrapMiddlewares(loginEndpoint, logger,
+	return WrapMiddlewares(loginEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeRefreshTokenEndpoint constructs a Refresh Token endpoint wrapping the service.
+func MakeRefreshTokenEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	refreshTokenEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RefreshTokenRequest)
+		token, err := s.RefreshToken(ctx, req.RefreshToken)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return TokenResponse{Err: err, Token: token}, nil
+	}
+	return WrapMiddlewares(refreshTokenEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeLogOutEndpoint constructs a Log Out endpoint wrapping the service.
+func MakeLogOutEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	logOutEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RefreshTokenRequest)
+		err = s.LogOut(ctx, req.RefreshToken)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return LogOutResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(logOutEndpoint, authenticator, logger,
 		duration, otTracer, zipkinTracer, operationName)
 }
 
@@ -171,6 +377,26 @@ func (s Set) CreateUser(ctx context.Context, user user_service.UserORM) (err err
 	return response.Err
 }
 
+// UpdateUser implements the service interface so that set may be used as a service.
+func (s Set) UpdateUser(ctx context.Context, user user_service.UserORM, updateMask *field_mask.FieldMask) (updated user_service.UserORM, err error) {
+	resp, err := s.UpdateUserEndpoint(ctx, UpdateUserRequest{User: user, UpdateMask: updateMask})
+	if err != nil {
+		return updated, err
+	}
+	response := resp.(UpdateUserResponse)
+	return response.User, response.Err
+}
+
+// DeleteUser implements the service interface so that set may be used as a service.
+func (s Set) DeleteUser(ctx context.Context, id int32) (err error) {
+	resp, err := s.DeleteUserEndpoint(ctx, DeleteUserRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	response := resp.(DeleteUserResponse)
+	return response.Err
+}
+
 // GetUserById implements the service interface so that set may be used as a service.
 func (s Set) GetUserById(ctx context.Context, id string) (user user_service.UserORM, err error) {
 	resp, err := s.GetUserByIdEndpoint(ctx, GetUserRequest{Param: id})
@@ -202,23 +428,49 @@ func (s Set) GetUserByUsername(ctx context.Context, username string) (user user_
 }
 
 // logIn implements the service interface so that set may be used as a service.
-func (s Set) logIn(ctx context.Context, username, password string) (user user_service.UserORM, err error) {
+func (s Set) logIn(ctx context.Context, username, password string) (user user_service.UserORM, token auth.TokenPair, challenge string, err error) {
 	resp, err := s.LoginEndpoint(ctx, LoginRequest{Username: username, Password: password})
-	response := resp.(GetUserResponse)
 	if err != nil {
-		return response.User, err
+		return user, token, challenge, err
 	}
-	return response.User, nil
+	response := resp.(LoginResponse)
+	return response.User, response.Token, response.Challenge, response.Err
+}
+
+// RefreshToken implements the service interface so that set may be used as a service.
+func (s Set) RefreshToken(ctx context.Context, refreshToken string) (token auth.TokenPair, err error) {
+	resp, err := s.RefreshTokenEndpoint(ctx, RefreshTokenRequest{RefreshToken: refreshToken})
+	if err != nil {
+		return token, err
+	}
+	response := resp.(TokenResponse)
+	return response.Token, response.Err
+}
+
+// LogOut implements the service interface so that set may be used as a service.
+func (s Set) LogOut(ctx context.Context, refreshToken string) (err error) {
+	resp, err := s.LogOutEndpoint(ctx, RefreshTokenRequest{RefreshToken: refreshToken})
+	if err != nil {
+		return err
+	}
+	response := resp.(LogOutResponse)
+	return response.Err
 }
 
 // WrapMiddlewares wraps endpointes in the following set of middlewares : ratelimiting,
-// circuit breaker, open and zipkin tracing, logging, and instrumentation middlewares
-func WrapMiddlewares(endpoint endpoint.Endpoint, logger *zap.Logger,
+// circuit breaker, authentication, open and zipkin tracing, logging, and instrumentation middlewares.
+// Authentication is only enforced on operations requiring a scope
+func WrapMiddlewares(endpoint endpoint.Endpoint, authenticator auth.Authenticator, logger *zap.Logger,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
 
 	endpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Every(time.Second), 5))(endpoint)
 	endpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(endpoint)
+	// authentication failures are applied outside of the circuit breaker so that
+	// unauthenticated callers cannot trip it
+	if scope, ok := requiredScopes[operationName]; ok {
+		endpoint = AuthMiddleware(authenticator, scope)(endpoint)
+	}
 	endpoint = opentracing.TraceServer(otTracer, operationName)(endpoint)
 	if zipkinTracer != nil {
 		endpoint = zipkin.TraceEndpoint(zipkinTracer, operationName)(endpoint)
@@ -233,7 +485,11 @@ func WrapMiddlewares(endpoint endpoint.Endpoint, logger *zap.Logger,
 // compile time assertions for our response types implementing endpoint.Failer.
 var (
 	_ endpoint.Failer = CreateUserResponse{}
+	_ endpoint.Failer = UpdateUserResponse{}
 	_ endpoint.Failer = GetUserResponse{}
+	_ endpoint.Failer = LoginResponse{}
+	_ endpoint.Failer = TokenResponse{}
+	_ endpoint.Failer = LogOutResponse{}
 )
 
 // ============================== Endpoint Request Definitions ======================
@@ -243,13 +499,29 @@ type CreateUserRequest struct {
 	User user_service.UserORM
 }
 
+// UpdateUserRequest collects the request parameters for the UpdateUser method.
+type UpdateUserRequest struct {
+	User       user_service.UserORM  `json:"user"`
+	UpdateMask *field_mask.FieldMask `json:"update_mask"`
+}
+
+// DeleteUserRequest collects the request parameters for the DeleteUser method.
+type DeleteUserRequest struct {
+	Id int32
+}
+
 type GetUserRequest struct {
 	Param string
 }
 
 type LoginRequest struct {
-	Username string
-	Password string
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// RefreshTokenRequest collects the request parameters for the RefreshToken and LogOut methods.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
 }
 
 // ============================== Endpoint Response Definitions ======================
@@ -259,13 +531,54 @@ type CreateUserResponse struct {
 	Err error `json:"err"` // should be intercepted by Failed/errorEncoder
 }
 
+// UpdateUserResponse collects the response values for the UpdateUser method.
+type UpdateUserResponse struct {
+	Err  error                `json:"err"`
+	User user_service.UserORM `json:"user"`
+}
+
+// DeleteUserResponse collects the response values for the DeleteUser method.
+type DeleteUserResponse struct {
+	Err error `json:"err"`
+}
+
 type GetUserResponse struct {
 	Err  error                `json:"err"`
 	User user_service.UserORM `json:"user"`
 }
 
+// LoginResponse collects the response values for the LogIn method. Users with two factor
+// authentication enabled are issued a challenge in place of a token pair.
+type LoginResponse struct {
+	Err       error                `json:"err"`
+	User      user_service.UserORM `json:"user"`
+	Token     auth.TokenPair       `json:"token"`
+	Challenge string               `json:"challenge_token,omitempty"`
+}
+
+// TokenResponse collects the response values for the RefreshToken method.
+type TokenResponse struct {
+	Err   error          `json:"err"`
+	Token auth.TokenPair `json:"token"`
+}
+
+// LogOutResponse collects the response values for the LogOut method.
+type LogOutResponse struct {
+	Err error `json:"err"`
+}
+
 // ============================== Endpoint Response Failed Definitions ======================
 func (r CreateUserResponse) error() error  { return r.Err }
 func (r CreateUserResponse) Failed() error { return r.Err }
+func (r UpdateUserResponse) error() error  { return r.Err }
+func (r UpdateUserResponse) Failed() error { return r.Err }
+func (r DeleteUserResponse) error() error  { return r.Err }
+func (r DeleteUserResponse) Failed() error { return r.Err }
 func (r GetUserResponse) error() error     { return r.Err }
 func (r GetUserResponse) Failed() error    { return r.Err }
+func (r LoginResponse) error() error       { return r.Err }
+func (r LoginResponse) Failed() error      { return r.Err }
+func (r TokenResponse) error() error       { return r.Err }
+func (r TokenResponse) Failed() error      { return r.Err }
+func (r LogOutResponse) error() error      { return r.Err }
+func (r LogOutResponse) Failed() error     { return r.Err }
@@ -0,0 +1,378 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeCreateTeamEndpoint constructs a Create Team endpoint wrapping the service.
+func MakeCreateTeamEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	createTeamEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(CreateTeamRequest)
+		err = s.CreateTeam(ctx, req.Team)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return CreateTeamResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(createTeamEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeUpdateTeamEndpoint constructs an Update Team endpoint wrapping the service.
+func MakeUpdateTeamEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	updateTeamEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UpdateTeamRequest)
+		err = s.UpdateTeam(ctx, req.Team)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return UpdateTeamResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(updateTeamEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeChangeTeamCredentialsEndpoint constructs a Change Team Credentials endpoint wrapping the service.
+func MakeChangeTeamCredentialsEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	changeTeamCredentialsEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ChangeTeamCredentialsRequest)
+		err = s.ChangeTeamCredentials(ctx, req.Id, req.CurrentPassword, req.Email, req.Password, req.PasswordConfirmed)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ChangeTeamCredentialsResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(changeTeamCredentialsEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeDeleteTeamEndpoint constructs a Delete Team endpoint wrapping the service.
+func MakeDeleteTeamEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	deleteTeamEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(DeleteTeamRequest)
+		err = s.DeleteTeam(ctx, req.Id)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return DeleteTeamResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(deleteTeamEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeSetTeamMemberEndpoint constructs a Set Team Member endpoint wrapping the service.
+func MakeSetTeamMemberEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	setTeamMemberEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(SetTeamMemberRequest)
+		err = s.SetTeamMember(ctx, req.TeamId, req.UserId, req.Role)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return SetTeamMemberResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(setTeamMemberEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeRemoveTeamMemberEndpoint constructs a Remove Team Member endpoint wrapping the service.
+func MakeRemoveTeamMemberEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	removeTeamMemberEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RemoveTeamMemberRequest)
+		err = s.RemoveTeamMember(ctx, req.TeamId, req.UserId)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return RemoveTeamMemberResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(removeTeamMemberEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeTeamLoginEndpoint constructs a Team Login endpoint wrapping the service.
+func MakeTeamLoginEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	teamLoginEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(TeamLoginRequest)
+		team, token, err := s.TeamLogIn(ctx, req.Name, req.Password)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return TeamLoginResponse{Err: err, Team: team, Token: token}, nil
+	}
+	return WrapMiddlewares(teamLoginEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeForgotTeamPasswordEndpoint constructs a Forgot Team Password endpoint wrapping the service.
+func MakeForgotTeamPasswordEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	forgotTeamPasswordEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ForgotPasswordRequest)
+		err = s.ForgotTeamPassword(ctx, req.Email)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ForgotPasswordResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(forgotTeamPasswordEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeResetTeamPasswordEndpoint constructs a Reset Team Password endpoint wrapping the service.
+func MakeResetTeamPasswordEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	resetTeamPasswordEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ResetPasswordRequest)
+		err = s.ResetTeamPassword(ctx, req.Token, req.Password, req.PasswordConfirmed)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ResetPasswordResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(resetTeamPasswordEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// CreateTeam implements the service interface so that set may be used as a service.
+func (s Set) CreateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	resp, err := s.CreateTeamEndpoint(ctx, CreateTeamRequest{Team: team})
+	if err != nil {
+		return err
+	}
+	response := resp.(CreateTeamResponse)
+	return response.Err
+}
+
+// UpdateTeam implements the service interface so that set may be used as a service.
+func (s Set) UpdateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	resp, err := s.UpdateTeamEndpoint(ctx, UpdateTeamRequest{Team: team})
+	if err != nil {
+		return err
+	}
+	response := resp.(UpdateTeamResponse)
+	return response.Err
+}
+
+// ChangeTeamCredentials implements the service interface so that set may be used as a service.
+func (s Set) ChangeTeamCredentials(ctx context.Context, id int32, currentPassword, email, password, passwordConfirmed string) (err error) {
+	resp, err := s.ChangeTeamCredentialsEndpoint(ctx, ChangeTeamCredentialsRequest{Id: id, CurrentPassword: currentPassword,
+		Email: email, Password: password, PasswordConfirmed: passwordConfirmed})
+	if err != nil {
+		return err
+	}
+	response := resp.(ChangeTeamCredentialsResponse)
+	return response.Err
+}
+
+// DeleteTeam implements the service interface so that set may be used as a service.
+func (s Set) DeleteTeam(ctx context.Context, id int32) (err error) {
+	resp, err := s.DeleteTeamEndpoint(ctx, DeleteTeamRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	response := resp.(DeleteTeamResponse)
+	return response.Err
+}
+
+// SetTeamMember implements the service interface so that set may be used as a service.
+func (s Set) SetTeamMember(ctx context.Context, teamId, userId int32, role string) (err error) {
+	resp, err := s.SetTeamMemberEndpoint(ctx, SetTeamMemberRequest{TeamId: teamId, UserId: userId, Role: role})
+	if err != nil {
+		return err
+	}
+	response := resp.(SetTeamMemberResponse)
+	return response.Err
+}
+
+// RemoveTeamMember implements the service interface so that set may be used as a service.
+func (s Set) RemoveTeamMember(ctx context.Context, teamId, userId int32) (err error) {
+	resp, err := s.RemoveTeamMemberEndpoint(ctx, RemoveTeamMemberRequest{TeamId: teamId, UserId: userId})
+	if err != nil {
+		return err
+	}
+	response := resp.(RemoveTeamMemberResponse)
+	return response.Err
+}
+
+// TeamLogIn implements the service interface so that set may be used as a service.
+func (s Set) TeamLogIn(ctx context.Context, name, password string) (team user_service.TeamORM, token auth.TokenPair, err error) {
+	resp, err := s.TeamLoginEndpoint(ctx, TeamLoginRequest{Name: name, Password: password})
+	if err != nil {
+		return team, token, err
+	}
+	response := resp.(TeamLoginResponse)
+	return response.Team, response.Token, response.Err
+}
+
+// ForgotTeamPassword implements the service interface so that set may be used as a service.
+func (s Set) ForgotTeamPassword(ctx context.Context, email string) (err error) {
+	resp, err := s.ForgotTeamPasswordEndpoint(ctx, ForgotPasswordRequest{Email: email})
+	if err != nil {
+		return err
+	}
+	response := resp.(ForgotPasswordResponse)
+	return response.Err
+}
+
+// ResetTeamPassword implements the service interface so that set may be used as a service.
+func (s Set) ResetTeamPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	resp, err := s.ResetTeamPasswordEndpoint(ctx, ResetPasswordRequest{Token: token, Password: password,
+		PasswordConfirmed: passwordConfirmed})
+	if err != nil {
+		return err
+	}
+	response := resp.(ResetPasswordResponse)
+	return response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = CreateTeamResponse{}
+	_ endpoint.Failer = UpdateTeamResponse{}
+	_ endpoint.Failer = ChangeTeamCredentialsResponse{}
+	_ endpoint.Failer = DeleteTeamResponse{}
+	_ endpoint.Failer = SetTeamMemberResponse{}
+	_ endpoint.Failer = RemoveTeamMemberResponse{}
+	_ endpoint.Failer = TeamLoginResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// CreateTeamRequest collects the request parameters for the CreateTeam method.
+type CreateTeamRequest struct {
+	Team user_service.TeamORM `json:"team"`
+}
+
+// UpdateTeamRequest collects the request parameters for the UpdateTeam method.
+type UpdateTeamRequest struct {
+	Team user_service.TeamORM `json:"team"`
+}
+
+// ChangeTeamCredentialsRequest collects the request parameters for the ChangeTeamCredentials method.
+type ChangeTeamCredentialsRequest struct {
+	Id                int32
+	CurrentPassword   string `json:"current_password"`
+	Email             string `json:"email"`
+	Password          string `json:"password"`
+	PasswordConfirmed string `json:"password_confirmed"`
+}
+
+// DeleteTeamRequest collects the request parameters for the DeleteTeam method.
+type DeleteTeamRequest struct {
+	Id int32
+}
+
+// SetTeamMemberRequest collects the request parameters for the SetTeamMember method.
+type SetTeamMemberRequest struct {
+	TeamId int32
+	UserId int32
+	Role   string `json:"role"`
+}
+
+// RemoveTeamMemberRequest collects the request parameters for the RemoveTeamMember method.
+type RemoveTeamMemberRequest struct {
+	TeamId int32
+	UserId int32
+}
+
+// TeamLoginRequest collects the request parameters for the TeamLogIn method.
+type TeamLoginRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// CreateTeamResponse collects the response values for the CreateTeam method.
+type CreateTeamResponse struct {
+	Err error `json:"err"`
+}
+
+// UpdateTeamResponse collects the response values for the UpdateTeam method.
+type UpdateTeamResponse struct {
+	Err error `json:"err"`
+}
+
+// ChangeTeamCredentialsResponse collects the response values for the ChangeTeamCredentials method.
+type ChangeTeamCredentialsResponse struct {
+	Err error `json:"err"`
+}
+
+// DeleteTeamResponse collects the response values for the DeleteTeam method.
+type DeleteTeamResponse struct {
+	Err error `json:"err"`
+}
+
+// SetTeamMemberResponse collects the response values for the SetTeamMember method.
+type SetTeamMemberResponse struct {
+	Err error `json:"err"`
+}
+
+// RemoveTeamMemberResponse collects the response values for the RemoveTeamMember method.
+type RemoveTeamMemberResponse struct {
+	Err error `json:"err"`
+}
+
+// TeamLoginResponse collects the response values for the TeamLogIn method.
+type TeamLoginResponse struct {
+	Err   error                `json:"err"`
+	Team  user_service.TeamORM `json:"team"`
+	Token auth.TokenPair       `json:"token"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r CreateTeamResponse) error() error        { return r.Err }
+func (r CreateTeamResponse) Failed() error       { return r.Err }
+func (r UpdateTeamResponse) error() error        { return r.Err }
+func (r UpdateTeamResponse) Failed() error       { return r.Err }
+func (r DeleteTeamResponse) error() error        { return r.Err }
+func (r DeleteTeamResponse) Failed() error       { return r.Err }
+func (r SetTeamMemberResponse) error() error     { return r.Err }
+func (r SetTeamMemberResponse) Failed() error    { return r.Err }
+func (r RemoveTeamMemberResponse) error() error  { return r.Err }
+func (r RemoveTeamMemberResponse) Failed() error { return r.Err }
+func (r TeamLoginResponse) error() error         { return r.Err }
+func (r TeamLoginResponse) Failed() error        { return r.Err }
+
+func (r ChangeTeamCredentialsResponse) error() error  { return r.Err }
+func (r ChangeTeamCredentialsResponse) Failed() error { return r.Err }
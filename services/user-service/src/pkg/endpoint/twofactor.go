@@ -0,0 +1,177 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeEnrollTwoFactorEndpoint constructs an Enroll Two Factor endpoint wrapping the service.
+func MakeEnrollTwoFactorEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	enrollTwoFactorEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		secret, uri, err := s.EnrollTwoFactor(ctx)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return EnrollTwoFactorResponse{Err: err, Secret: secret, URI: uri}, nil
+	}
+	return WrapMiddlewares(enrollTwoFactorEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeConfirmTwoFactorEndpoint constructs a Confirm Two Factor endpoint wrapping the service.
+func MakeConfirmTwoFactorEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	confirmTwoFactorEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(TwoFactorCodeRequest)
+		recoveryCodes, err := s.ConfirmTwoFactor(ctx, req.Code)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ConfirmTwoFactorResponse{Err: err, RecoveryCodes: recoveryCodes}, nil
+	}
+	return WrapMiddlewares(confirmTwoFactorEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeDisableTwoFactorEndpoint constructs a Disable Two Factor endpoint wrapping the service.
+func MakeDisableTwoFactorEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	disableTwoFactorEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(TwoFactorCodeRequest)
+		err = s.DisableTwoFactor(ctx, req.Code)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return DisableTwoFactorResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(disableTwoFactorEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeVerifyTwoFactorEndpoint constructs a Verify Two Factor endpoint wrapping the service.
+func MakeVerifyTwoFactorEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	verifyTwoFactorEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(VerifyTwoFactorRequest)
+		user, token, err := s.VerifyTwoFactor(ctx, req.Challenge, req.Code)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return LoginResponse{Err: err, User: user, Token: token}, nil
+	}
+	return WrapMiddlewares(verifyTwoFactorEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// EnrollTwoFactor implements the service interface so that set may be used as a service.
+func (s Set) EnrollTwoFactor(ctx context.Context) (secret string, uri string, err error) {
+	resp, err := s.EnrollTwoFactorEndpoint(ctx, nil)
+	if err != nil {
+		return "", "", err
+	}
+	response := resp.(EnrollTwoFactorResponse)
+	return response.Secret, response.URI, response.Err
+}
+
+// ConfirmTwoFactor implements the service interface so that set may be used as a service.
+func (s Set) ConfirmTwoFactor(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	resp, err := s.ConfirmTwoFactorEndpoint(ctx, TwoFactorCodeRequest{Code: code})
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(ConfirmTwoFactorResponse)
+	return response.RecoveryCodes, response.Err
+}
+
+// DisableTwoFactor implements the service interface so that set may be used as a service.
+func (s Set) DisableTwoFactor(ctx context.Context, code string) (err error) {
+	resp, err := s.DisableTwoFactorEndpoint(ctx, TwoFactorCodeRequest{Code: code})
+	if err != nil {
+		return err
+	}
+	response := resp.(DisableTwoFactorResponse)
+	return response.Err
+}
+
+// VerifyTwoFactor implements the service interface so that set may be used as a service.
+func (s Set) VerifyTwoFactor(ctx context.Context, challenge, code string) (user user_service.UserORM, token auth.TokenPair, err error) {
+	resp, err := s.VerifyTwoFactorEndpoint(ctx, VerifyTwoFactorRequest{Challenge: challenge, Code: code})
+	if err != nil {
+		return user, token, err
+	}
+	response := resp.(LoginResponse)
+	return response.User, response.Token, response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = EnrollTwoFactorResponse{}
+	_ endpoint.Failer = ConfirmTwoFactorResponse{}
+	_ endpoint.Failer = DisableTwoFactorResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// TwoFactorCodeRequest collects the request parameters for the ConfirmTwoFactor and DisableTwoFactor methods.
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// VerifyTwoFactorRequest collects the request parameters for the VerifyTwoFactor method.
+type VerifyTwoFactorRequest struct {
+	Challenge string `json:"challenge_token"`
+	Code      string `json:"code"`
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// EnrollTwoFactorResponse collects the response values for the EnrollTwoFactor method. The uri
+// is meant to be rendered as a QR code and scanned by an authenticator app.
+type EnrollTwoFactorResponse struct {
+	Err    error  `json:"err"`
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// ConfirmTwoFactorResponse collects the response values for the ConfirmTwoFactor method.
+type ConfirmTwoFactorResponse struct {
+	Err           error    `json:"err"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// DisableTwoFactorResponse collects the response values for the DisableTwoFactor method.
+type DisableTwoFactorResponse struct {
+	Err error `json:"err"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r EnrollTwoFactorResponse) error() error   { return r.Err }
+func (r EnrollTwoFactorResponse) Failed() error  { return r.Err }
+func (r ConfirmTwoFactorResponse) error() error  { return r.Err }
+func (r ConfirmTwoFactorResponse) Failed() error { return r.Err }
+func (r DisableTwoFactorResponse) error() error  { return r.Err }
+func (r DisableTwoFactorResponse) Failed() error { return r.Err }
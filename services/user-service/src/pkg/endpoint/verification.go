@@ -0,0 +1,110 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/metrics"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
+)
+
+// ============================== Endpoint Definitions ======================
+
+// MakeVerifyEmailEndpoint constructs a Verify Email endpoint wrapping the service.
+func MakeVerifyEmailEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	verifyEmailEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(VerifyEmailRequest)
+		err = s.VerifyEmail(ctx, req.Token)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return VerifyEmailResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(verifyEmailEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// MakeResendVerificationEndpoint constructs a Resend Verification endpoint wrapping the service.
+func MakeResendVerificationEndpoint(s service.Service, authenticator auth.Authenticator, logger *zap.Logger,
+	duration metrics.Histogram, otTracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer, operationName string) endpoint.Endpoint {
+
+	resendVerificationEndpoint := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ResendVerificationRequest)
+		err = s.ResendVerification(ctx, req.Email)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return ResendVerificationResponse{Err: err}, nil
+	}
+	return WrapMiddlewares(resendVerificationEndpoint, authenticator, logger,
+		duration, otTracer, zipkinTracer, operationName)
+}
+
+// ============================== Endpoint Service Interface Impl  ======================
+
+// VerifyEmail implements the service interface so that set may be used as a service.
+func (s Set) VerifyEmail(ctx context.Context, token string) (err error) {
+	resp, err := s.VerifyEmailEndpoint(ctx, VerifyEmailRequest{Token: token})
+	if err != nil {
+		return err
+	}
+	response := resp.(VerifyEmailResponse)
+	return response.Err
+}
+
+// ResendVerification implements the service interface so that set may be used as a service.
+func (s Set) ResendVerification(ctx context.Context, email string) (err error) {
+	resp, err := s.ResendVerificationEndpoint(ctx, ResendVerificationRequest{Email: email})
+	if err != nil {
+		return err
+	}
+	response := resp.(ResendVerificationResponse)
+	return response.Err
+}
+
+// ============================== Endpoint Fail Time Assertions ======================
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = VerifyEmailResponse{}
+	_ endpoint.Failer = ResendVerificationResponse{}
+)
+
+// ============================== Endpoint Request Definitions ======================
+
+// VerifyEmailRequest collects the request parameters for the VerifyEmail method.
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// ResendVerificationRequest collects the request parameters for the ResendVerification method.
+type ResendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+// ============================== Endpoint Response Definitions ======================
+
+// VerifyEmailResponse collects the response values for the VerifyEmail method.
+type VerifyEmailResponse struct {
+	Err error `json:"err"`
+}
+
+// ResendVerificationResponse collects the response values for the ResendVerification method.
+type ResendVerificationResponse struct {
+	Err error `json:"err"`
+}
+
+// ============================== Endpoint Response Failed Definitions ======================
+func (r VerifyEmailResponse) error() error         { return r.Err }
+func (r VerifyEmailResponse) Failed() error        { return r.Err }
+func (r ResendVerificationResponse) error() error  { return r.Err }
+func (r ResendVerificationResponse) Failed() error { return r.Err }
@@ -2,6 +2,7 @@ package helper
 
 import (
 	"errors"
+	"strings"
 )
 
 var (
@@ -31,4 +32,112 @@ var (
 	ErrAlreadyExists = errors.New("already exists")
 	// Inconsistent Mapping Between Route and Handler Error
 	ErrBadRouting = errors.New("inconsistent mapping between route and handler (programmer error)")
+	// Invalid Refresh Token Error
+	ErrInvalidRefreshToken = errors.New("invalid refresh token provided")
+	// Expired Refresh Token Error
+	ErrRefreshTokenExpired = errors.New("refresh token expired")
+	// Reused Refresh Token Error
+	ErrRefreshTokenReused = errors.New("refresh token already used, token family revoked")
+	// Unauthorized Error
+	ErrUnauthorized = errors.New("missing or invalid credentials")
+	// Expired Access Token Error
+	ErrAccessTokenExpired = errors.New("access token expired")
+	// Forbidden Error
+	ErrForbidden = errors.New("insufficient scope")
+	// Missing Credentials Error
+	ErrMissingCredentials = errors.New("no credentials provided")
+	// Invalid Api Key Error
+	ErrInvalidAPIKey = errors.New("invalid api key provided")
+	// Expired Api Key Error
+	ErrAPIKeyExpired = errors.New("api key expired")
+	// Invalid Scope Error
+	ErrInvalidScope = errors.New("invalid scope requested")
+
+	// Invalid Password Reset Token Error
+	ErrInvalidResetToken = errors.New("invalid password reset token provided")
+	// Expired Password Reset Token Error
+	ErrResetTokenExpired = errors.New("password reset token expired")
+	// Invalid Email Change Token Error
+	ErrInvalidEmailChangeToken = errors.New("invalid email change token provided")
+	// Expired Email Change Token Error
+	ErrEmailChangeExpired = errors.New("email change token expired")
+	// Invalid Verification Token Error
+	ErrInvalidVerificationToken = errors.New("invalid verification token provided")
+	// Expired Verification Token Error
+	ErrVerificationTokenExpired = errors.New("verification token expired")
+	// Account Not Verified Error
+	ErrAccountNotVerified = errors.New("account email not verified")
+	// Invalid Two Factor Code Error
+	ErrInvalidTwoFactorCode = errors.New("invalid two factor authentication code provided")
+	// Invalid Two Factor Challenge Error
+	ErrInvalidChallengeToken = errors.New("invalid two factor challenge provided")
+	// Expired Two Factor Challenge Error
+	ErrChallengeTokenExpired = errors.New("two factor challenge expired")
+	// Two Factor Already Enabled Error
+	ErrTwoFactorAlreadyEnabled = errors.New("two factor authentication already enabled")
+	// Two Factor Not Enabled Error
+	ErrTwoFactorNotEnabled = errors.New("two factor authentication not enabled")
+	// Too Many Log In Attempts Error
+	ErrTooManyLoginAttempts = errors.New("too many failed log in attempts, try again later")
+	// Log In Locked Error
+	ErrLoginLocked = errors.New("log in temporarily locked due to too many failed attempts")
+	// Session Revoked Error
+	ErrSessionRevoked = errors.New("session revoked")
+	// Invalid Page Token Error
+	ErrInvalidPageToken = errors.New("invalid page token provided")
+	// Invalid Sort Key Error
+	ErrInvalidSortKey = errors.New("invalid sort key provided")
+	// Unsupported Filter Error
+	ErrUnsupportedFilter = errors.New("filter is not supported by the listing")
+	// Invalid Role Error
+	ErrInvalidRole = errors.New("invalid role provided")
+	// Last Owner Error
+	ErrLastOwner = errors.New("teams and groups must keep at least one owner")
+	// Protected Field Error
+	ErrProtectedField = errors.New("field mask includes fields that cannot be updated")
+	// Restore Period Elapsed Error
+	ErrRestorePeriodElapsed = errors.New("the grace period to restore the user has elapsed")
+	// Export Not Ready Error
+	ErrExportNotReady = errors.New("the data export is not ready to be downloaded")
+	// Invalid Erasure Receipt Error
+	ErrInvalidErasureReceipt = errors.New("the erasure receipt was not issued by the service")
+	// Erasure Incomplete Error
+	ErrErasureIncomplete = errors.New("personal data of the user could not be entirely erased")
+	// Impersonation Forbidden Error
+	ErrImpersonationForbidden = errors.New("operation not permitted while impersonating a user")
+
+	// The following errors are named after the error codes defined by the oauth2 specification (RFC 6749)
+
+	// Invalid OAuth Request Error
+	ErrInvalidRequest = errors.New("invalid_request")
+	// Invalid OAuth Client Error
+	ErrInvalidClient = errors.New("invalid_client")
+	// Invalid OAuth Grant Error
+	ErrInvalidGrant = errors.New("invalid_grant")
+	// Unsupported OAuth Grant Type Error
+	ErrUnsupportedGrantType = errors.New("unsupported_grant_type")
+	// Unsupported OAuth Response Type Error
+	ErrUnsupportedResponseType = errors.New("unsupported_response_type")
+	// OAuth Consent Required Error
+	ErrConsentRequired = errors.New("consent_required")
 )
+
+// PasswordPolicyViolation describes a password policy rule a password failed to satisfy
+type PasswordPolicyViolation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// PasswordPolicyError is returned when a password violates one or more rules of the password policy.
+// Every violated rule is reported so that clients can surface all of them at once
+type PasswordPolicyError struct {
+	Violations []PasswordPolicyViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	rules := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		rules = append(rules, violation.Rule)
+	}
+	return "password does not satisfy the password policy: " + strings.Join(rules, ", ")
+}
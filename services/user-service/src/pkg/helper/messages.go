@@ -1,9 +1,44 @@
 package helper
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 )
 
+// UserErasedEventType names the event published once the personal data of a user was erased
+const UserErasedEventType = "user.erased"
+
+// EmailMessage witholds the recipient and content of an email published to one of the email queues
+type EmailMessage struct {
+	UserId  int32  `json:"user_id"`
+	TeamId  int32  `json:"team_id,omitempty"`
+	Email   string `json:"email"`
+	Message string `json:"message"`
+}
+
+// String serializes an email message so that it may be published to a queue
+func (m EmailMessage) String() string {
+	data, _ := json.Marshal(m)
+	return string(data)
+}
+
+// UserErasedEvent notifies downstream services that the personal data of a user was erased so that
+// they may erase their own copies
+type UserErasedEvent struct {
+	Event     string    `json:"event"`
+	UserId    int32     `json:"user_id"`
+	AccountID string    `json:"account_id"`
+	ReceiptId string    `json:"receipt_id"`
+	ErasedAt  time.Time `json:"erased_at"`
+}
+
+// String serializes an event so that it may be published to a queue
+func (e UserErasedEvent) String() string {
+	data, _ := json.Marshal(e)
+	return string(data)
+}
+
 func WelcomeMessage(firstname, lastname string) string {
 	name := fmt.Sprintf("%s %s", firstname, lastname)
 	message := "Dear " + name + " \n Thank you for opening a new account on the CUBE platform. We look " +
@@ -12,3 +47,59 @@ func WelcomeMessage(firstname, lastname string) string {
 		"\n" + "Thank you for being our customer"
 	return message
 }
+
+func VerificationMessage(firstname, lastname, link string) string {
+	message := WelcomeMessage(firstname, lastname) +
+		"\n" +
+		"\n" + "Please verify your email address in order to activate your account: " +
+		"\n" + link
+	return message
+}
+
+func PasswordResetMessage(firstname, lastname, link string) string {
+	name := fmt.Sprintf("%s %s", firstname, lastname)
+	message := "Dear " + name + " \n We received a request to reset the password of your account on the CUBE " +
+		"platform. Use the following link to choose a new password: " +
+		"\n" + link +
+		"\n" +
+		"\n" + "If you did not request a password reset you may safely ignore this email"
+	return message
+}
+
+func TeamPasswordResetMessage(teamName, link string) string {
+	message := "Dear " + teamName + " team \n We received a request to reset the password of your team account on " +
+		"the CUBE platform. Use the following link to choose a new password: " +
+		"\n" + link +
+		"\n" +
+		"\n" + "If you did not request a password reset you may safely ignore this email"
+	return message
+}
+
+func EmailChangeConfirmationMessage(firstname, lastname, link string) string {
+	name := fmt.Sprintf("%s %s", firstname, lastname)
+	message := "Dear " + name + " \n We received a request to use this address for your account on the CUBE " +
+		"platform. Use the following link to confirm the change: " +
+		"\n" + link +
+		"\n" +
+		"\n" + "If you did not request this change you may safely ignore this email"
+	return message
+}
+
+func EmailChangeNoticeMessage(firstname, lastname, newEmail string) string {
+	name := fmt.Sprintf("%s %s", firstname, lastname)
+	message := "Dear " + name + " \n We received a request to change the email of your account on the CUBE " +
+		"platform to " + newEmail + ". The change only takes effect once confirmed from the new address." +
+		"\n" +
+		"\n" + "If you did not request this change please reset your password and contact our support"
+	return message
+}
+
+func DataExportReadyMessage(firstname, lastname, link string) string {
+	name := fmt.Sprintf("%s %s", firstname, lastname)
+	message := "Dear " + name + " \n The export of the data held about you on the CUBE platform is ready. Use the " +
+		"following link to download it: " +
+		"\n" + link +
+		"\n" +
+		"\n" + "The export will remain available for a limited time only"
+	return message
+}
@@ -0,0 +1,84 @@
+package helper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	// DefaultPageSize is the amount of items returned by paginated apis when none is requested
+	DefaultPageSize = 20
+	// MaxPageSize is the maximum amount of items returned by paginated apis
+	MaxPageSize = 100
+)
+
+// PageSize bounds the amount of items requested from a paginated api
+func PageSize(requested int) int {
+	if requested <= 0 {
+		return DefaultPageSize
+	}
+	if requested > MaxPageSize {
+		return MaxPageSize
+	}
+	return requested
+}
+
+// EncodePageToken returns the opaque token pointing past the item with the given id
+func EncodePageToken(id int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(id))))
+}
+
+// DecodePageToken obtains the id of the last item of the previous page from an opaque page token.
+// An empty token denotes the first page
+func DecodePageToken(token string) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+
+	id, err := strconv.ParseInt(string(raw), 10, 32)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return int32(id), nil
+}
+
+// Cursor points past the last item of a page of a sorted listing. The sort key and order are kept
+// so that a cursor may not be reused against a listing sorted differently
+type Cursor struct {
+	SortBy     string `json:"s"`
+	Descending bool   `json:"d,omitempty"`
+	Value      string `json:"v,omitempty"`
+	Id         int32  `json:"i"`
+}
+
+// EncodeCursor returns the opaque form of a cursor
+func EncodeCursor(cursor Cursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor obtains a cursor from its opaque form. An empty token denotes the first page
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.Id <= 0 {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &cursor, nil
+}
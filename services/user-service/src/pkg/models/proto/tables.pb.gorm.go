@@ -1419,7 +1419,9 @@ type SettingsORM struct {
 	PaymentId         *PaymentsORM     `gorm:"foreignkey:SettingsId;association_foreignkey:Id"`
 	PrivacyId         *PrivacyORM      `gorm:"foreignkey:SettingsId;association_foreignkey:Id"`
 	TeamProfileId     *int32
+	TwoFactorEnabled  bool
 	UpdatedAt         *time.Time
+	UserId            int32 `gorm:"index:idx_settings_user_id"`
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -1488,6 +1490,8 @@ func (m *Settings) ToORM(ctx context.Context) (SettingsORM, error) {
 		}
 		to.PaymentId = &tempPaymentId
 	}
+	to.TwoFactorEnabled = m.TwoFactorEnabled
+	to.UserId = m.UserId
 	if posthook, ok := interface{}(m).(SettingsWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -1547,6 +1551,8 @@ func (m *SettingsORM) ToPB(ctx context.Context) (Settings, error) {
 		}
 		to.PaymentId = &tempPaymentId
 	}
+	to.TwoFactorEnabled = m.TwoFactorEnabled
+	to.UserId = m.UserId
 	if posthook, ok := interface{}(m).(SettingsWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -1577,9 +1583,15 @@ type SettingsWithAfterToPB interface {
 }
 
 type LoginActivityORM struct {
-	Date     string
-	Id       int32       `gorm:"type:integer;primary_key"`
-	Location *AddressORM `gorm:"foreignkey:LoginActivityId;association_foreignkey:Id"`
+	CreatedAt *time.Time
+	Date      string
+	Id        int32 `gorm:"type:integer;primary_key"`
+	IpAddress string
+	Location  *AddressORM `gorm:"foreignkey:LoginActivityId;association_foreignkey:Id"`
+	Outcome   string
+	UserAgent string
+	UserId    int32 `gorm:"index:idx_login_activities_user_id"`
+	Username  string
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -1606,6 +1618,18 @@ func (m *LoginActivity) ToORM(ctx context.Context) (LoginActivityORM, error) {
 		to.Location = &tempLocation
 	}
 	to.Date = m.Date
+	to.UserId = m.UserId
+	to.Username = m.Username
+	to.IpAddress = m.IpAddress
+	to.UserAgent = m.UserAgent
+	to.Outcome = m.Outcome
+	if m.CreatedAt != nil {
+		var t time.Time
+		if t, err = ptypes1.Timestamp(m.CreatedAt); err != nil {
+			return to, err
+		}
+		to.CreatedAt = &t
+	}
 	if posthook, ok := interface{}(m).(LoginActivityWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -1631,6 +1655,16 @@ func (m *LoginActivityORM) ToPB(ctx context.Context) (LoginActivity, error) {
 		to.Location = &tempLocation
 	}
 	to.Date = m.Date
+	to.UserId = m.UserId
+	to.Username = m.Username
+	to.IpAddress = m.IpAddress
+	to.UserAgent = m.UserAgent
+	to.Outcome = m.Outcome
+	if m.CreatedAt != nil {
+		if to.CreatedAt, err = ptypes1.TimestampProto(*m.CreatedAt); err != nil {
+			return to, err
+		}
+	}
 	if posthook, ok := interface{}(m).(LoginActivityWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -6492,6 +6526,14 @@ func DefaultApplyFieldMaskSettings(ctx context.Context, patchee *Settings, patch
 			patchee.PaymentId = patcher.PaymentId
 			continue
 		}
+		if f == prefix+"TwoFactorEnabled" {
+			patchee.TwoFactorEnabled = patcher.TwoFactorEnabled
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -6872,6 +6914,30 @@ func DefaultApplyFieldMaskLoginActivity(ctx context.Context, patchee *LoginActiv
 			patchee.Date = patcher.Date
 			continue
 		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"Username" {
+			patchee.Username = patcher.Username
+			continue
+		}
+		if f == prefix+"IpAddress" {
+			patchee.IpAddress = patcher.IpAddress
+			continue
+		}
+		if f == prefix+"UserAgent" {
+			patchee.UserAgent = patcher.UserAgent
+			continue
+		}
+		if f == prefix+"Outcome" {
+			patchee.Outcome = patcher.Outcome
+			continue
+		}
+		if f == prefix+"CreatedAt" {
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
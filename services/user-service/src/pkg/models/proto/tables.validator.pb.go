@@ -399,6 +399,11 @@ func (this *LoginActivity) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Location", err)
 		}
 	}
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
 	return nil
 }
 func (this *Payments) Validate() error {
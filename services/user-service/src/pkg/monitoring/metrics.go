@@ -11,7 +11,7 @@ import (
 // InitMetrics Creates the (sparse) metrics used in the service.
 func InitMetrics() service.Counters {
 	var createUserReq, successfulCreateUserReq, failedCreateUserReq, getUserRequests, successfulGetUserReq,
-	failedGetUserReq, successfulLogInReq, failedLogInReq metrics.Counter
+	failedGetUserReq, successfulLogInReq, failedLogInReq, accountLockouts metrics.Counter
 	{
 		// Business-level metrics.
 		createUserReq = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -62,6 +62,12 @@ func InitMetrics() service.Counters {
 			Name:      "login_requests_failed_ops",
 			Help:      "Total count of failed login requests.",
 		}, []string{})
+		accountLockouts = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "users",
+			Subsystem: "users",
+			Name:      "login_lockouts",
+			Help:      "Total count of log in lockouts by scope (username or ip).",
+		}, []string{"scope"})
 	}
 
 	var duration metrics.Histogram
@@ -84,6 +90,7 @@ func InitMetrics() service.Counters {
 		FailedGetUserRequest:        failedGetUserReq,
 		SuccessfulLogInRequest:      successfulLogInReq,
 		FailedLogInRequest:          failedLogInReq,
+		AccountLockouts:             accountLockouts,
 		Duration:                    duration,
 	}
 
@@ -0,0 +1,4 @@
+/*
+Package rbac witholds the roles users hold on teams and groups and the permissions they grant
+*/
+package rbac
@@ -0,0 +1,65 @@
+package rbac
+
+// Resources users hold roles on
+const (
+	TeamResource  = "team"
+	GroupResource = "group"
+)
+
+// Roles users may hold on teams and groups
+const (
+	RoleOwner   = "owner"
+	RoleAdmin   = "admin"
+	RoleMember  = "member"
+	RoleAdvisor = "advisor"
+)
+
+// Permissions granted by roles on teams and groups
+const (
+	// PermissionView grants access to the details of a team or group
+	PermissionView = "view"
+	// PermissionUpdate grants updating a team or group
+	PermissionUpdate = "update"
+	// PermissionDelete grants deleting a team or group
+	PermissionDelete = "delete"
+	// PermissionManageMembers grants adding and removing members and advisors
+	PermissionManageMembers = "manage_members"
+	// PermissionManageRoles grants granting and revoking the owner and admin roles
+	PermissionManageRoles = "manage_roles"
+	// PermissionManageAPIKeys grants issuing and revoking api keys belonging to a team
+	PermissionManageAPIKeys = "manage_api_keys"
+	// PermissionManageCredentials grants replacing the email and password of a team without knowing its
+	// current password
+	PermissionManageCredentials = "manage_credentials"
+)
+
+// rolePermissions maps every role to the set of permissions it grants
+var rolePermissions = map[string][]string{
+	RoleOwner: {PermissionView, PermissionUpdate, PermissionDelete, PermissionManageMembers, PermissionManageRoles,
+		PermissionManageAPIKeys, PermissionManageCredentials},
+	RoleAdmin:   {PermissionView, PermissionUpdate, PermissionManageMembers, PermissionManageAPIKeys},
+	RoleMember:  {PermissionView, PermissionManageAPIKeys},
+	RoleAdvisor: {PermissionView},
+}
+
+// IsKnownRole reports whether a role is defined by the user service
+func IsKnownRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// IsPrivilegedRole reports whether a role may only be granted or revoked by principals allowed to
+// manage roles
+func IsPrivilegedRole(role string) bool {
+	return role == RoleOwner || role == RoleAdmin
+}
+
+// RoleHasPermission reports whether a role grants a given permission
+func RoleHasPermission(role string, permission string) bool {
+	for _, granted := range rolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
+)
+
+// CreateAPIKey issues an api key to the authenticated user or, if a team id is provided, to one
+// of the teams the user belongs to. Requested scopes may not exceed the scopes of the caller
+func (s basicService) CreateAPIKey(ctx context.Context, name string, teamId int32, scopes []string,
+	expiresIn time.Duration) (key string, apiKey database.APIKeyORM, err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return "", apiKey, err
+	}
+
+	if len(scopes) == 0 {
+		scopes = principal.Scopes
+	}
+
+	for _, scope := range scopes {
+		if !auth.IsKnownScope(scope) || !principal.HasScope(scope) {
+			s.logger.Error(helper.ErrInvalidScope.Error())
+			return "", apiKey, helper.ErrInvalidScope
+		}
+	}
+
+	if expiresIn <= 0 || expiresIn > config.Config.APIKeyExpiry {
+		expiresIn = config.Config.APIKeyExpiry
+	}
+	expiresAt := time.Now().Add(expiresIn)
+
+	key, prefix, err := auth.NewAPIKey()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return "", apiKey, err
+	}
+
+	apiKey = database.APIKeyORM{
+		Name:        name,
+		Prefix:      prefix,
+		KeyHash:     auth.HashToken(key),
+		CreatedById: principal.UserId,
+		AccountID:   principal.AccountID,
+		Scopes:      scopes,
+		ExpiresAt:   &expiresAt,
+	}
+
+	if teamId != 0 {
+		if err := s.ensureTeamMember(principal.UserId, teamId); err != nil {
+			return "", database.APIKeyORM{}, err
+		}
+		apiKey.TeamId = &teamId
+	} else {
+		userId := principal.UserId
+		apiKey.UserId = &userId
+	}
+
+	if err := s.database.CreateAPIKey(&apiKey); err != nil {
+		return "", database.APIKeyORM{}, err
+	}
+
+	s.logger.Info("Api key issued", zap.Int32("id", apiKey.Id), zap.String("prefix", prefix))
+	return key, apiKey, nil
+}
+
+// ListAPIKeys lists the api keys belonging to the authenticated user as well as, if a team id
+// is provided, the api keys belonging to that team
+func (s basicService) ListAPIKeys(ctx context.Context, teamId int32) (keys []*database.APIKeyORM, err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if teamId != 0 {
+		if err := s.ensureTeamMember(principal.UserId, teamId); err != nil {
+			return nil, err
+		}
+	}
+
+	err, keys = s.database.GetAPIKeysByOwner(principal.UserId, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes an api key belonging to the authenticated user or to one of their teams
+func (s basicService) RevokeAPIKey(ctx context.Context, id int32) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	err, apiKey := s.database.GetAPIKeyById(id)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrNotFound
+		}
+		return err
+	}
+
+	switch {
+	case apiKey.UserId != nil && *apiKey.UserId == principal.UserId:
+	case apiKey.TeamId != nil:
+		if err := s.ensureTeamMember(principal.UserId, *apiKey.TeamId); err != nil {
+			return err
+		}
+	default:
+		return helper.ErrForbidden
+	}
+
+	return s.database.RevokeAPIKey(id)
+}
+
+// firstPartyPrincipal obtains the authenticated principal and ensures it is a user authenticated
+// through a first party token. Api keys may only be managed by such principals, never through
+// another api key or by an oauth client
+func (s basicService) firstPartyPrincipal(ctx context.Context) (auth.Principal, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || principal.UserId == 0 {
+		return auth.Principal{}, helper.ErrUnauthorized
+	}
+
+	if !principal.IsFirstParty() {
+		return auth.Principal{}, helper.ErrForbidden
+	}
+
+	return principal, nil
+}
+
+// ensureTeamMember checks that a given user holds a role on a given team allowing them to manage the
+// api keys of the team
+func (s basicService) ensureTeamMember(userId int32, teamId int32) error {
+	err, membership := s.database.GetMembership(rbac.TeamResource, teamId, userId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrForbidden
+		}
+		return err
+	}
+
+	if !rbac.RoleHasPermission(membership.Role, rbac.PermissionManageAPIKeys) {
+		return helper.ErrForbidden
+	}
+
+	return nil
+}
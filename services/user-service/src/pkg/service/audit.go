@@ -0,0 +1,413 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// Outcomes of operations recorded as audit records
+const (
+	auditSucceeded = "success"
+	auditFailed    = "failure"
+)
+
+// AuditStore persists the audit trail of the operations performed while impersonating users
+type AuditStore interface {
+	CreateAuditRecord(record database.AuditRecordORM) error
+}
+
+// AuditMiddleware returns a middleware recording an audit record for every operation performed by
+// a staff member impersonating a user, whether it succeeded or not
+func AuditMiddleware(audits AuditStore, logger *zap.Logger) Middleware {
+	return func(next Service) Service {
+		return auditMiddleware{audits: audits, logger: logger, next: next}
+	}
+}
+
+// Audit Struct that implements the Service interface
+type auditMiddleware struct {
+	audits AuditStore
+	logger *zap.Logger
+	next   Service
+}
+
+// An auditing wrapper around the UpdateUser service implementation
+func (mw auditMiddleware) UpdateUser(ctx context.Context, user user_service.UserORM, updateMask *field_mask.FieldMask) (updated user_service.UserORM, err error) {
+	defer func() { mw.record(ctx, "UpdateUser", err) }()
+	return mw.next.UpdateUser(ctx, user, updateMask)
+}
+
+// An auditing wrapper around the LogIn service implementation
+func (mw auditMiddleware) LogIn(ctx context.Context, username, password string) (user user_service.UserORM, token auth.TokenPair, challenge string, err error) {
+	defer func() { mw.record(ctx, "LogIn", err) }()
+	return mw.next.LogIn(ctx, username, password)
+}
+
+// An auditing wrapper around the RefreshToken service implementation
+func (mw auditMiddleware) RefreshToken(ctx context.Context, refreshToken string) (token auth.TokenPair, err error) {
+	defer func() { mw.record(ctx, "RefreshToken", err) }()
+	return mw.next.RefreshToken(ctx, refreshToken)
+}
+
+// An auditing wrapper around the LogOut service implementation
+func (mw auditMiddleware) LogOut(ctx context.Context, refreshToken string) (err error) {
+	defer func() { mw.record(ctx, "LogOut", err) }()
+	return mw.next.LogOut(ctx, refreshToken)
+}
+
+// An auditing wrapper around the CreateAPIKey service implementation
+func (mw auditMiddleware) CreateAPIKey(ctx context.Context, name string, teamId int32, scopes []string,
+	expiresIn time.Duration) (key string, apiKey database.APIKeyORM, err error) {
+	defer func() { mw.record(ctx, "CreateAPIKey", err) }()
+	return mw.next.CreateAPIKey(ctx, name, teamId, scopes, expiresIn)
+}
+
+// An auditing wrapper around the ListAPIKeys service implementation
+func (mw auditMiddleware) ListAPIKeys(ctx context.Context, teamId int32) (keys []*database.APIKeyORM, err error) {
+	defer func() { mw.record(ctx, "ListAPIKeys", err) }()
+	return mw.next.ListAPIKeys(ctx, teamId)
+}
+
+// An auditing wrapper around the RevokeAPIKey service implementation
+func (mw auditMiddleware) RevokeAPIKey(ctx context.Context, id int32) (err error) {
+	defer func() { mw.record(ctx, "RevokeAPIKey", err) }()
+	return mw.next.RevokeAPIKey(ctx, id)
+}
+
+// An auditing wrapper around the RegisterOAuthClient service implementation
+func (mw auditMiddleware) RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes []string,
+	public bool) (secret string, client database.OAuthClientORM, err error) {
+	defer func() { mw.record(ctx, "RegisterOAuthClient", err) }()
+	return mw.next.RegisterOAuthClient(ctx, name, redirectURIs, scopes, public)
+}
+
+// An auditing wrapper around the Authorize service implementation
+func (mw auditMiddleware) Authorize(ctx context.Context, req auth.AuthorizationRequest, grantConsent bool) (redirectURI string, err error) {
+	defer func() { mw.record(ctx, "Authorize", err) }()
+	return mw.next.Authorize(ctx, req, grantConsent)
+}
+
+// An auditing wrapper around the ExchangeToken service implementation
+func (mw auditMiddleware) ExchangeToken(ctx context.Context, req auth.TokenRequest) (token auth.TokenPair, err error) {
+	defer func() { mw.record(ctx, "ExchangeToken", err) }()
+	return mw.next.ExchangeToken(ctx, req)
+}
+
+// An auditing wrapper around the GetOpenIDConfiguration service implementation
+func (mw auditMiddleware) GetOpenIDConfiguration(ctx context.Context) (configuration auth.OpenIDConfiguration, err error) {
+	defer func() { mw.record(ctx, "GetOpenIDConfiguration", err) }()
+	return mw.next.GetOpenIDConfiguration(ctx)
+}
+
+// An auditing wrapper around the GetJSONWebKeySet service implementation
+func (mw auditMiddleware) GetJSONWebKeySet(ctx context.Context) (keys auth.JSONWebKeySet, err error) {
+	defer func() { mw.record(ctx, "GetJSONWebKeySet", err) }()
+	return mw.next.GetJSONWebKeySet(ctx)
+}
+
+// An auditing wrapper around the ForgotPassword service implementation
+func (mw auditMiddleware) ForgotPassword(ctx context.Context, email string) (err error) {
+	defer func() { mw.record(ctx, "ForgotPassword", err) }()
+	return mw.next.ForgotPassword(ctx, email)
+}
+
+// An auditing wrapper around the ResetPassword service implementation
+func (mw auditMiddleware) ResetPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	defer func() { mw.record(ctx, "ResetPassword", err) }()
+	return mw.next.ResetPassword(ctx, token, password, passwordConfirmed)
+}
+
+// An auditing wrapper around the ChangeEmail service implementation
+func (mw auditMiddleware) ChangeEmail(ctx context.Context, email string) (err error) {
+	defer func() { mw.record(ctx, "ChangeEmail", err) }()
+	return mw.next.ChangeEmail(ctx, email)
+}
+
+// An auditing wrapper around the ConfirmEmailChange service implementation
+func (mw auditMiddleware) ConfirmEmailChange(ctx context.Context, token string) (err error) {
+	defer func() { mw.record(ctx, "ConfirmEmailChange", err) }()
+	return mw.next.ConfirmEmailChange(ctx, token)
+}
+
+// An auditing wrapper around the VerifyEmail service implementation
+func (mw auditMiddleware) VerifyEmail(ctx context.Context, token string) (err error) {
+	defer func() { mw.record(ctx, "VerifyEmail", err) }()
+	return mw.next.VerifyEmail(ctx, token)
+}
+
+// An auditing wrapper around the ResendVerification service implementation
+func (mw auditMiddleware) ResendVerification(ctx context.Context, email string) (err error) {
+	defer func() { mw.record(ctx, "ResendVerification", err) }()
+	return mw.next.ResendVerification(ctx, email)
+}
+
+// An auditing wrapper around the EnrollTwoFactor service implementation
+func (mw auditMiddleware) EnrollTwoFactor(ctx context.Context) (secret string, uri string, err error) {
+	defer func() { mw.record(ctx, "EnrollTwoFactor", err) }()
+	return mw.next.EnrollTwoFactor(ctx)
+}
+
+// An auditing wrapper around the ConfirmTwoFactor service implementation
+func (mw auditMiddleware) ConfirmTwoFactor(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	defer func() { mw.record(ctx, "ConfirmTwoFactor", err) }()
+	return mw.next.ConfirmTwoFactor(ctx, code)
+}
+
+// An auditing wrapper around the DisableTwoFactor service implementation
+func (mw auditMiddleware) DisableTwoFactor(ctx context.Context, code string) (err error) {
+	defer func() { mw.record(ctx, "DisableTwoFactor", err) }()
+	return mw.next.DisableTwoFactor(ctx, code)
+}
+
+// An auditing wrapper around the VerifyTwoFactor service implementation
+func (mw auditMiddleware) VerifyTwoFactor(ctx context.Context, challenge, code string) (user user_service.UserORM, token auth.TokenPair, err error) {
+	defer func() { mw.record(ctx, "VerifyTwoFactor", err) }()
+	return mw.next.VerifyTwoFactor(ctx, challenge, code)
+}
+
+// An auditing wrapper around the UnlockUser service implementation
+func (mw auditMiddleware) UnlockUser(ctx context.Context, id int32) (err error) {
+	defer func() { mw.record(ctx, "UnlockUser", err) }()
+	return mw.next.UnlockUser(ctx, id)
+}
+
+// An auditing wrapper around the DeleteUser service implementation
+func (mw auditMiddleware) DeleteUser(ctx context.Context, id int32) (err error) {
+	defer func() { mw.record(ctx, "DeleteUser", err) }()
+	return mw.next.DeleteUser(ctx, id)
+}
+
+// An auditing wrapper around the RestoreUser service implementation
+func (mw auditMiddleware) RestoreUser(ctx context.Context, id int32) (err error) {
+	defer func() { mw.record(ctx, "RestoreUser", err) }()
+	return mw.next.RestoreUser(ctx, id)
+}
+
+// An auditing wrapper around the RequestDataExport service implementation
+func (mw auditMiddleware) RequestDataExport(ctx context.Context, id int32) (export database.DataExportORM, err error) {
+	defer func() { mw.record(ctx, "RequestDataExport", err) }()
+	return mw.next.RequestDataExport(ctx, id)
+}
+
+// An auditing wrapper around the DownloadDataExport service implementation
+func (mw auditMiddleware) DownloadDataExport(ctx context.Context, id int32, exportId int32) (archive []byte, err error) {
+	defer func() { mw.record(ctx, "DownloadDataExport", err) }()
+	return mw.next.DownloadDataExport(ctx, id, exportId)
+}
+
+// An auditing wrapper around the EraseUser service implementation
+func (mw auditMiddleware) EraseUser(ctx context.Context, id int32) (receipt database.ErasureReceiptORM, err error) {
+	defer func() { mw.record(ctx, "EraseUser", err) }()
+	return mw.next.EraseUser(ctx, id)
+}
+
+// An auditing wrapper around the VerifyErasureReceipt service implementation
+func (mw auditMiddleware) VerifyErasureReceipt(ctx context.Context, receipt string) (verified database.ErasureReceiptORM, err error) {
+	defer func() { mw.record(ctx, "VerifyErasureReceipt", err) }()
+	return mw.next.VerifyErasureReceipt(ctx, receipt)
+}
+
+// An auditing wrapper around the ListUsers service implementation
+func (mw auditMiddleware) ListUsers(ctx context.Context, query database.ListQuery, cursor string) (users []*user_service.UserORM, nextCursor string, err error) {
+	defer func() { mw.record(ctx, "ListUsers", err) }()
+	return mw.next.ListUsers(ctx, query, cursor)
+}
+
+// An auditing wrapper around the ListTeams service implementation
+func (mw auditMiddleware) ListTeams(ctx context.Context, query database.ListQuery, cursor string) (teams []*user_service.TeamORM, nextCursor string, err error) {
+	defer func() { mw.record(ctx, "ListTeams", err) }()
+	return mw.next.ListTeams(ctx, query, cursor)
+}
+
+// An auditing wrapper around the ListGroups service implementation
+func (mw auditMiddleware) ListGroups(ctx context.Context, query database.ListQuery, cursor string) (groups []*user_service.GroupORM, nextCursor string, err error) {
+	defer func() { mw.record(ctx, "ListGroups", err) }()
+	return mw.next.ListGroups(ctx, query, cursor)
+}
+
+// An auditing wrapper around the GetUsersByType service implementation
+func (mw auditMiddleware) GetUsersByType(ctx context.Context, query string, maxResults int32, accountType user_service.AccountType) (users []*user_service.UserORM, err error) {
+	defer func() { mw.record(ctx, "GetUsersByType", err) }()
+	return mw.next.GetUsersByType(ctx, query, maxResults, accountType)
+}
+
+// An auditing wrapper around the Impersonate service implementation
+func (mw auditMiddleware) Impersonate(ctx context.Context, userId int32) (token auth.TokenPair, err error) {
+	defer func() { mw.record(ctx, "Impersonate", err) }()
+	return mw.next.Impersonate(ctx, userId)
+}
+
+// An auditing wrapper around the GetLoginActivity service implementation
+func (mw auditMiddleware) GetLoginActivity(ctx context.Context, id int32, pageSize int, pageToken string) (activities []*user_service.LoginActivityORM, nextPageToken string, err error) {
+	defer func() { mw.record(ctx, "GetLoginActivity", err) }()
+	return mw.next.GetLoginActivity(ctx, id, pageSize, pageToken)
+}
+
+// An auditing wrapper around the ChangePassword service implementation
+func (mw auditMiddleware) ChangePassword(ctx context.Context, currentPassword, password, passwordConfirmed string) (err error) {
+	defer func() { mw.record(ctx, "ChangePassword", err) }()
+	return mw.next.ChangePassword(ctx, currentPassword, password, passwordConfirmed)
+}
+
+// An auditing wrapper around the ListSessions service implementation
+func (mw auditMiddleware) ListSessions(ctx context.Context) (sessions []*database.SessionORM, err error) {
+	defer func() { mw.record(ctx, "ListSessions", err) }()
+	return mw.next.ListSessions(ctx)
+}
+
+// An auditing wrapper around the RevokeSession service implementation
+func (mw auditMiddleware) RevokeSession(ctx context.Context, id int32) (err error) {
+	defer func() { mw.record(ctx, "RevokeSession", err) }()
+	return mw.next.RevokeSession(ctx, id)
+}
+
+// An auditing wrapper around the RevokeAllSessions service implementation
+func (mw auditMiddleware) RevokeAllSessions(ctx context.Context) (err error) {
+	defer func() { mw.record(ctx, "RevokeAllSessions", err) }()
+	return mw.next.RevokeAllSessions(ctx)
+}
+
+// An auditing wrapper around the CreateTeam service implementation
+func (mw auditMiddleware) CreateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	defer func() { mw.record(ctx, "CreateTeam", err) }()
+	return mw.next.CreateTeam(ctx, team)
+}
+
+// An auditing wrapper around the UpdateTeam service implementation
+func (mw auditMiddleware) UpdateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	defer func() { mw.record(ctx, "UpdateTeam", err) }()
+	return mw.next.UpdateTeam(ctx, team)
+}
+
+// An auditing wrapper around the ChangeTeamCredentials service implementation
+func (mw auditMiddleware) ChangeTeamCredentials(ctx context.Context, id int32, currentPassword, email, password, passwordConfirmed string) (err error) {
+	defer func() { mw.record(ctx, "ChangeTeamCredentials", err) }()
+	return mw.next.ChangeTeamCredentials(ctx, id, currentPassword, email, password, passwordConfirmed)
+}
+
+// An auditing wrapper around the TeamLogIn service implementation
+func (mw auditMiddleware) TeamLogIn(ctx context.Context, name, password string) (team user_service.TeamORM, token auth.TokenPair, err error) {
+	defer func() { mw.record(ctx, "TeamLogIn", err) }()
+	return mw.next.TeamLogIn(ctx, name, password)
+}
+
+// An auditing wrapper around the ForgotTeamPassword service implementation
+func (mw auditMiddleware) ForgotTeamPassword(ctx context.Context, email string) (err error) {
+	defer func() { mw.record(ctx, "ForgotTeamPassword", err) }()
+	return mw.next.ForgotTeamPassword(ctx, email)
+}
+
+// An auditing wrapper around the ResetTeamPassword service implementation
+func (mw auditMiddleware) ResetTeamPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	defer func() { mw.record(ctx, "ResetTeamPassword", err) }()
+	return mw.next.ResetTeamPassword(ctx, token, password, passwordConfirmed)
+}
+
+// An auditing wrapper around the DeleteTeam service implementation
+func (mw auditMiddleware) DeleteTeam(ctx context.Context, id int32) (err error) {
+	defer func() { mw.record(ctx, "DeleteTeam", err) }()
+	return mw.next.DeleteTeam(ctx, id)
+}
+
+// An auditing wrapper around the SetTeamMember service implementation
+func (mw auditMiddleware) SetTeamMember(ctx context.Context, teamId, userId int32, role string) (err error) {
+	defer func() { mw.record(ctx, "SetTeamMember", err) }()
+	return mw.next.SetTeamMember(ctx, teamId, userId, role)
+}
+
+// An auditing wrapper around the RemoveTeamMember service implementation
+func (mw auditMiddleware) RemoveTeamMember(ctx context.Context, teamId, userId int32) (err error) {
+	defer func() { mw.record(ctx, "RemoveTeamMember", err) }()
+	return mw.next.RemoveTeamMember(ctx, teamId, userId)
+}
+
+// An auditing wrapper around the CreateGroup service implementation
+func (mw auditMiddleware) CreateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	defer func() { mw.record(ctx, "CreateGroup", err) }()
+	return mw.next.CreateGroup(ctx, group)
+}
+
+// An auditing wrapper around the UpdateGroup service implementation
+func (mw auditMiddleware) UpdateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	defer func() { mw.record(ctx, "UpdateGroup", err) }()
+	return mw.next.UpdateGroup(ctx, group)
+}
+
+// An auditing wrapper around the DeleteGroup service implementation
+func (mw auditMiddleware) DeleteGroup(ctx context.Context, id int32) (err error) {
+	defer func() { mw.record(ctx, "DeleteGroup", err) }()
+	return mw.next.DeleteGroup(ctx, id)
+}
+
+// An auditing wrapper around the SetGroupMember service implementation
+func (mw auditMiddleware) SetGroupMember(ctx context.Context, groupId, userId int32, role string) (err error) {
+	defer func() { mw.record(ctx, "SetGroupMember", err) }()
+	return mw.next.SetGroupMember(ctx, groupId, userId, role)
+}
+
+// An auditing wrapper around the RemoveGroupMember service implementation
+func (mw auditMiddleware) RemoveGroupMember(ctx context.Context, groupId, userId int32) (err error) {
+	defer func() { mw.record(ctx, "RemoveGroupMember", err) }()
+	return mw.next.RemoveGroupMember(ctx, groupId, userId)
+}
+
+// An auditing wrapper around the GetUserById service implementation
+func (mw auditMiddleware) GetUserById(ctx context.Context, id string) (user user_service.UserORM, err error) {
+	defer func() { mw.record(ctx, "GetUserById", err) }()
+	return mw.next.GetUserById(ctx, id)
+}
+
+// An auditing wrapper around the GetUserByEmail service implementation
+func (mw auditMiddleware) GetUserByEmail(ctx context.Context, email string) (user user_service.UserORM, err error) {
+	defer func() { mw.record(ctx, "GetUserByEmail", err) }()
+	return mw.next.GetUserByEmail(ctx, email)
+}
+
+// An auditing wrapper around the GetUserByUsername service implementation
+func (mw auditMiddleware) GetUserByUsername(ctx context.Context, username string) (user user_service.UserORM, err error) {
+	defer func() { mw.record(ctx, "GetUserByUsername", err) }()
+	return mw.next.GetUserByUsername(ctx, username)
+}
+
+// An auditing wrapper around the CreateUser service implementation
+func (mw auditMiddleware) CreateUser(ctx context.Context, user user_service.UserORM) (err error) {
+	defer func() { mw.record(ctx, "CreateUser", err) }()
+	return mw.next.CreateUser(ctx, user)
+}
+
+// record writes an audit record for an operation if it was performed while impersonating a user. Failing
+// to record it does not fail the operation, which already completed
+func (mw auditMiddleware) record(ctx context.Context, operation string, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || !principal.IsImpersonated() {
+		return
+	}
+
+	record := database.AuditRecordORM{
+		ActorId:   principal.ActorId,
+		SubjectId: principal.UserId,
+		AccountID: principal.AccountID,
+		Operation: operation,
+		Outcome:   auditSucceeded,
+	}
+	if err != nil {
+		record.Outcome = auditFailed
+		record.Error = err.Error()
+	}
+
+	client := auth.ClientFromContext(ctx)
+	record.IpAddress = client.IPAddress
+	record.UserAgent = client.UserAgent
+
+	if err := mw.audits.CreateAuditRecord(record); err != nil {
+		mw.logger.Error("failed to record audit record", zap.String("operation", operation),
+			zap.Int32("actor_id", principal.ActorId), zap.Int32("subject_id", principal.UserId), zap.Error(err))
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// purgeBatchSize is the maximum number of users purged per round
+const purgeBatchSize = 100
+
+// DeleteUser soft deletes a given user on behalf of the user or of platform staff. Every credential
+// of the user is revoked, the user may be restored until the deletion grace period elapses
+func (s basicService) DeleteUser(ctx context.Context, id int32) (err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || principal.UserId == 0 {
+		return helper.ErrUnauthorized
+	}
+
+	if principal.UserId != id && !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return helper.ErrForbidden
+	}
+
+	if err := s.database.DeleteUser(ctx, user_service.UserORM{Id: id}); err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrNotFound
+		}
+		return err
+	}
+
+	if err := s.database.RevokeUserRefreshTokens(id); err != nil {
+		return err
+	}
+
+	if err := s.database.RevokeUserAPIKeys(id); err != nil {
+		return err
+	}
+
+	s.logger.Info("User deleted", zap.Int32("user_id", id))
+	return nil
+}
+
+// RestoreUser restores a deleted user provided the deletion grace period has not elapsed. Reserved
+// to platform staff
+func (s basicService) RestoreUser(ctx context.Context, id int32) (err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return helper.ErrForbidden
+	}
+
+	err = s.database.RestoreUser(ctx, id, time.Now().Add(-config.Config.DeletionGrace))
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrNotFound
+		}
+		return err
+	}
+
+	s.logger.Info("User restored", zap.Int32("user_id", id), zap.Int32("actor_id", principal.UserId))
+	return nil
+}
+
+// An authorizing wrapper around the DeleteUser service implementation
+func (mw authorizationMiddleware) DeleteUser(ctx context.Context, id int32) (err error) {
+	if err := mw.rejectImpersonation(ctx, "DeleteUser"); err != nil {
+		return err
+	}
+	return mw.Service.DeleteUser(ctx, id)
+}
+
+// DeletedUserStore is the subset of the backend datastore needed to purge deleted users
+type DeletedUserStore interface {
+	GetDeletedUsers(deletedBefore time.Time, limit int) (error, []*user_service.UserORM)
+	PurgeUser(id int32) error
+}
+
+// UserPurger permanently deletes users once the deletion grace period has elapsed alongside their
+// profile, address, education and experience
+type UserPurger struct {
+	store       DeletedUserStore
+	logger      *zap.Logger
+	gracePeriod time.Duration
+	interval    time.Duration
+}
+
+// NewUserPurger returns a purger honouring the configured deletion grace period
+func NewUserPurger(store DeletedUserStore, logger *zap.Logger, config *config.Configuration) *UserPurger {
+	return &UserPurger{
+		store:       store,
+		logger:      logger,
+		gracePeriod: config.DeletionGrace,
+		interval:    config.PurgeInterval,
+	}
+}
+
+// Run periodically purges the users whose deletion grace period has elapsed. It blocks until done
+// is closed
+func (p *UserPurger) Run(done <-chan struct{}) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.Purge()
+		case <-done:
+			return
+		}
+	}
+}
+
+// Purge performs a single round of purging
+func (p *UserPurger) Purge() {
+	for {
+		err, users := p.store.GetDeletedUsers(time.Now().Add(-p.gracePeriod), purgeBatchSize)
+		if err != nil {
+			return
+		}
+
+		purged := 0
+		for _, user := range users {
+			if err := p.store.PurgeUser(user.Id); err != nil {
+				continue
+			}
+			purged++
+			p.logger.Info("User purged", zap.Int32("user_id", user.Id))
+		}
+
+		// a partially purged batch would be listed again, leave it to the next round
+		if len(users) < purgeBatchSize || purged < len(users) {
+			return
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+)
+
+// GetOpenIDConfiguration returns the OpenID Connect discovery document of the authorization server
+func (s basicService) GetOpenIDConfiguration(ctx context.Context) (auth.OpenIDConfiguration, error) {
+	return s.tokens.OpenIDConfiguration(), nil
+}
+
+// GetJSONWebKeySet returns the public keys other services may verify issued tokens with
+func (s basicService) GetJSONWebKeySet(ctx context.Context) (auth.JSONWebKeySet, error) {
+	return s.tokens.JSONWebKeySet(), nil
+}
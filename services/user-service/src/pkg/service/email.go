@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// ChangeEmail records a new email address as pending for the authenticated user. A confirmation
+// link is emailed to the new address while the current address is notified of the request. The
+// email of the user only changes once the new address is confirmed
+func (s basicService) ChangeEmail(ctx context.Context, email string) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := validate.Var(email, "required,email"); err != nil {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return helper.ErrInvalidArgumentProvided
+	}
+
+	err, user := s.database.GetUserById(ctx, principal.UserId)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(user.Email, email) {
+		return helper.ErrAlreadyExists
+	}
+
+	token, err := auth.NewOpaqueToken()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	expiresAt := time.Now().Add(config.Config.EmailChangeExpiry)
+	err = s.database.CreateEmailChange(database.EmailChangeORM{
+		UserId:    user.Id,
+		NewEmail:  email,
+		TokenHash: auth.HashToken(token),
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	link := strings.TrimSuffix(config.Config.AppUrl, "/") + "/email/confirm?token=" + url.QueryEscape(token)
+	confirmation := helper.EmailMessage{
+		UserId:  user.Id,
+		Email:   email,
+		Message: helper.EmailChangeConfirmationMessage(user.FirstName, user.LastName, link),
+	}
+	notice := helper.EmailMessage{
+		UserId:  user.Id,
+		Email:   user.Email,
+		Message: helper.EmailChangeNoticeMessage(user.FirstName, user.LastName, email),
+	}
+
+	for _, message := range []helper.EmailMessage{confirmation, notice} {
+		err = s.ProducerQueues.SendMessageToQueue(message.String(), "lens_email_reset_email")
+		if err != nil {
+			s.logger.Error(err.Error())
+			return err
+		}
+	}
+
+	s.logger.Info("Email change requested", zap.Int32("user_id", user.Id))
+	return nil
+}
+
+// ConfirmEmailChange consumes an email change token and swaps the email of its owner for the
+// address the token was sent to
+func (s basicService) ConfirmEmailChange(ctx context.Context, token string) (err error) {
+	if token == "" {
+		s.logger.Error(helper.ErrInvalidEmailChangeToken.Error())
+		return helper.ErrInvalidEmailChangeToken
+	}
+
+	err, change := s.database.GetEmailChangeByHash(auth.HashToken(token))
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrInvalidEmailChangeToken
+		}
+		return err
+	}
+
+	if change.ConfirmedAt != nil {
+		return helper.ErrInvalidEmailChangeToken
+	}
+
+	if change.ExpiresAt == nil || change.ExpiresAt.Before(time.Now()) {
+		s.logger.Error(helper.ErrEmailChangeExpired.Error(), zap.Int32("user_id", change.UserId))
+		return helper.ErrEmailChangeExpired
+	}
+
+	if err := s.database.ConfirmEmailChange(*change); err != nil {
+		return err
+	}
+
+	s.logger.Info("Email changed", zap.Int32("user_id", change.UserId))
+	return nil
+}
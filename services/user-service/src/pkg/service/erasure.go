@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// userErasedQueue is the queue notified once the personal data of a user was erased
+const userErasedQueue = "lens_user_erased"
+
+// EraseUser anonymizes the personal data held about a given user on behalf of the user or of platform
+// staff and returns a signed receipt of the erasure. Resources solely administered by the user are
+// handed over to another member. Erasing a user twice returns the original receipt
+func (s basicService) EraseUser(ctx context.Context, id int32) (receipt database.ErasureReceiptORM, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || principal.UserId == 0 {
+		return receipt, helper.ErrUnauthorized
+	}
+
+	if principal.UserId != id && !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return receipt, helper.ErrForbidden
+	}
+
+	err, erased := s.database.EraseUser(ctx, id, func(receipt *database.ErasureReceiptORM) error {
+		receipt.ActorId = principal.UserId
+		return s.tokens.SealErasureReceipt(receipt)
+	})
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return receipt, helper.ErrNotFound
+		}
+		return receipt, err
+	}
+
+	// the event is published again whenever the erasure is requested so that a failed publication may
+	// be retried
+	event := helper.UserErasedEvent{
+		Event:     helper.UserErasedEventType,
+		UserId:    erased.UserId,
+		AccountID: erased.AccountID,
+		ReceiptId: erased.ReceiptId,
+		ErasedAt:  erased.ErasedAt,
+	}
+	if err := s.ProducerQueues.SendMessageToQueue(event.String(), userErasedQueue); err != nil {
+		s.logger.Error(err.Error(), zap.Int32("user_id", id))
+		return receipt, err
+	}
+
+	s.logger.Info("User erased", zap.Int32("user_id", id), zap.Int32("actor_id", principal.UserId),
+		zap.String("receipt_id", erased.ReceiptId))
+	return *erased, nil
+}
+
+// VerifyErasureReceipt checks the signature of an erasure receipt and that the service issued it, then
+// returns the erasure it attests
+func (s basicService) VerifyErasureReceipt(ctx context.Context, receipt string) (verified database.ErasureReceiptORM, err error) {
+	claims, err := s.tokens.ParseErasureReceipt(receipt)
+	if err != nil {
+		return verified, err
+	}
+
+	err, issued := s.database.GetErasureReceipt(claims.Id)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return verified, helper.ErrInvalidErasureReceipt
+		}
+		return verified, err
+	}
+
+	if err := auth.VerifyErasureReceipt(receipt, *issued); err != nil {
+		s.logger.Error(err.Error(), zap.String("receipt_id", claims.Id))
+		return verified, err
+	}
+
+	return *issued, nil
+}
+
+// An authorizing wrapper around the EraseUser service implementation
+func (mw authorizationMiddleware) EraseUser(ctx context.Context, id int32) (receipt database.ErasureReceiptORM, err error) {
+	if err := mw.rejectImpersonation(ctx, "EraseUser"); err != nil {
+		return receipt, err
+	}
+	return mw.Service.EraseUser(ctx, id)
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/queues"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
+)
+
+const (
+	// exportBatchSize is the maximum number of exports built per round
+	exportBatchSize = 10
+
+	// dataExportReadyQueue is the queue notified once an export may be downloaded
+	dataExportReadyQueue = "lens_data_export_ready"
+)
+
+// RequestDataExport schedules an export of the data held about a given user on behalf of the user or
+// of platform staff. The export is built asynchronously
+func (s basicService) RequestDataExport(ctx context.Context, id int32) (export database.DataExportORM, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || principal.UserId == 0 {
+		return export, helper.ErrUnauthorized
+	}
+
+	if principal.UserId != id && !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return export, helper.ErrForbidden
+	}
+
+	if err, _ := s.database.GetUserById(ctx, id); err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return export, helper.ErrNotFound
+		}
+		return export, err
+	}
+
+	export = database.DataExportORM{UserId: id}
+	if err := s.database.CreateDataExport(&export); err != nil {
+		return database.DataExportORM{}, err
+	}
+
+	s.logger.Info("Data export requested", zap.Int32("user_id", id), zap.Int32("export_id", export.Id))
+	return export, nil
+}
+
+// DownloadDataExport returns the zip archive of a completed export of the data held about a given user
+func (s basicService) DownloadDataExport(ctx context.Context, id int32, exportId int32) (archive []byte, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || principal.UserId == 0 {
+		return nil, helper.ErrUnauthorized
+	}
+
+	if principal.UserId != id && !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return nil, helper.ErrForbidden
+	}
+
+	err, export := s.database.GetDataExport(exportId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, helper.ErrNotFound
+		}
+		return nil, err
+	}
+
+	// exports of other users are reported as missing rather than forbidden
+	if export.UserId != id || (export.ExpiresAt != nil && export.ExpiresAt.Before(time.Now())) {
+		s.logger.Error(helper.ErrNotFound.Error(), zap.Int32("export_id", exportId))
+		return nil, helper.ErrNotFound
+	}
+
+	if export.Status != database.DataExportCompleted {
+		s.logger.Error(helper.ErrExportNotReady.Error(), zap.Int32("export_id", exportId), zap.String("status", export.Status))
+		return nil, helper.ErrExportNotReady
+	}
+
+	return export.Archive, nil
+}
+
+// An authorizing wrapper around the RequestDataExport service implementation
+func (mw authorizationMiddleware) RequestDataExport(ctx context.Context, id int32) (export database.DataExportORM, err error) {
+	if err := mw.rejectImpersonation(ctx, "RequestDataExport"); err != nil {
+		return export, err
+	}
+	return mw.Service.RequestDataExport(ctx, id)
+}
+
+// An authorizing wrapper around the DownloadDataExport service implementation
+func (mw authorizationMiddleware) DownloadDataExport(ctx context.Context, id int32, exportId int32) (archive []byte, err error) {
+	if err := mw.rejectImpersonation(ctx, "DownloadDataExport"); err != nil {
+		return nil, err
+	}
+	return mw.Service.DownloadDataExport(ctx, id, exportId)
+}
+
+// DataExportStore is the subset of the backend datastore needed to build data exports
+type DataExportStore interface {
+	GetPendingDataExports(limit int) (error, []*database.DataExportORM)
+	CompleteDataExport(id int32, archive []byte, expiresAt time.Time) error
+	FailDataExport(id int32, reason string) error
+	DeleteExpiredDataExports() error
+	GetUserData(userId int32) (error, *database.UserData)
+}
+
+// DataExporter builds pending data exports into zip archives of JSON files and notifies the export
+// ready queue once an archive may be downloaded
+type DataExporter struct {
+	store     DataExportStore
+	queue     queues.Queue
+	logger    *zap.Logger
+	expiry    time.Duration
+	interval  time.Duration
+	publicUrl string
+}
+
+// NewDataExporter returns an exporter keeping archives for the configured export expiry
+func NewDataExporter(store DataExportStore, queue queues.Queue, logger *zap.Logger, config *config.Configuration) *DataExporter {
+	return &DataExporter{
+		store:     store,
+		queue:     queue,
+		logger:    logger,
+		expiry:    config.ExportExpiry,
+		interval:  config.ExportInterval,
+		publicUrl: config.PublicUrl,
+	}
+}
+
+// Run periodically builds pending exports and deletes expired ones. It blocks until done is closed
+func (e *DataExporter) Run(done <-chan struct{}) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.Export()
+		case <-done:
+			return
+		}
+	}
+}
+
+// Export performs a single round of exporting
+func (e *DataExporter) Export() {
+	err, exports := e.store.GetPendingDataExports(exportBatchSize)
+	if err != nil {
+		return
+	}
+
+	for _, export := range exports {
+		e.build(export)
+	}
+
+	_ = e.store.DeleteExpiredDataExports()
+}
+
+// build gathers the data of the owner of an export into an archive and notifies the owner
+func (e *DataExporter) build(export *database.DataExportORM) {
+	err, data := e.store.GetUserData(export.UserId)
+	if err != nil {
+		_ = e.store.FailDataExport(export.Id, err.Error())
+		return
+	}
+
+	archive, err := newDataExportArchive(data)
+	if err != nil {
+		e.logger.Error(err.Error(), zap.Int32("export_id", export.Id))
+		_ = e.store.FailDataExport(export.Id, err.Error())
+		return
+	}
+
+	if err := e.store.CompleteDataExport(export.Id, archive, time.Now().Add(e.expiry)); err != nil {
+		return
+	}
+
+	link := fmt.Sprintf("%s/v1/user/%d/exports/%d/download", strings.TrimSuffix(e.publicUrl, "/"), export.UserId, export.Id)
+	message := helper.EmailMessage{
+		UserId:  data.User.Id,
+		Email:   data.User.Email,
+		Message: helper.DataExportReadyMessage(data.User.FirstName, data.User.LastName, link),
+	}
+
+	// the archive remains downloadable even if the owner could not be notified
+	if err := e.queue.SendMessageToQueue(message.String(), dataExportReadyQueue); err != nil {
+		e.logger.Error(err.Error(), zap.Int32("export_id", export.Id))
+	}
+
+	e.logger.Info("Data export completed", zap.Int32("user_id", export.UserId), zap.Int32("export_id", export.Id),
+		zap.Int("size", len(archive)))
+}
+
+// exportFile is a JSON file of a data export archive
+type exportFile struct {
+	name    string
+	content interface{}
+}
+
+// newDataExportArchive writes the data held about a user as a zip archive holding one JSON file per
+// kind of record. Credentials are left out of the archive
+func newDataExportArchive(data *database.UserData) ([]byte, error) {
+	user := sanitizeUser(*data.User)
+	user.ProfileId = nil
+	user.SubscriptionsId = nil
+
+	files := []exportFile{
+		{"user.json", user},
+		{"subscriptions.json", data.User.SubscriptionsId},
+		{"settings.json", data.Settings},
+		{"login_activity.json", data.LoginActivity},
+		{"team_memberships.json", membershipsOf(data.Memberships, rbac.TeamResource)},
+		{"group_memberships.json", membershipsOf(data.Memberships, rbac.GroupResource)},
+	}
+
+	if profile := data.Profile; profile != nil {
+		stripped := *profile
+		stripped.AddressId = nil
+		stripped.EducationId = nil
+		stripped.ExperienceId = nil
+		stripped.SocialMedia = nil
+		stripped.GroupId = nil
+		stripped.TeamId = nil
+		stripped.SettingsId = nil
+
+		files = append(files,
+			exportFile{"profile.json", stripped},
+			exportFile{"address.json", profile.AddressId},
+			exportFile{"education.json", profile.EducationId},
+			exportFile{"experience.json", profile.ExperienceId},
+			exportFile{"social_media.json", profile.SocialMedia},
+		)
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, file := range files {
+		w, err := archive.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := json.MarshalIndent(file.content, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// membershipsOf returns the memberships held on a given kind of resource
+func membershipsOf(memberships []*database.MembershipORM, resourceType string) []*database.MembershipORM {
+	filtered := make([]*database.MembershipORM, 0, len(memberships))
+	for _, membership := range memberships {
+		if membership.ResourceType == resourceType {
+			filtered = append(filtered, membership)
+		}
+	}
+	return filtered
+}
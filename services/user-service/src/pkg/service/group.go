@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
+)
+
+// CreateGroup creates a group owned by the authenticated user
+func (s basicService) CreateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.CreateGroup(group); err != nil {
+		return err
+	}
+
+	err, createdGroup := s.database.GetGroupByName(group.Name)
+	if err != nil {
+		return err
+	}
+
+	// the creator of a group owns it
+	err = s.database.SetMembership(database.MembershipORM{ResourceType: rbac.GroupResource, ResourceId: createdGroup.Id,
+		UserId: principal.UserId, Role: rbac.RoleOwner})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Group added", zap.Int32("group_id", createdGroup.Id), zap.Int32("owner_id", principal.UserId))
+	return nil
+}
+
+// UpdateGroup updates a group
+func (s basicService) UpdateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	current, err := s.getGroup(group.Id)
+	if err != nil {
+		return err
+	}
+
+	group.CreatedAt = current.CreatedAt
+	if err := s.database.UpdateGroup(group); err != nil {
+		return err
+	}
+
+	s.logger.Info("Group updated", zap.Int32("group_id", group.Id))
+	return nil
+}
+
+// DeleteGroup deletes a group alongside every role held on it
+func (s basicService) DeleteGroup(ctx context.Context, id int32) (err error) {
+	group, err := s.getGroup(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.DeleteGroup(*group); err != nil {
+		return err
+	}
+
+	s.logger.Info("Group deleted", zap.Int32("group_id", id))
+	return nil
+}
+
+// SetGroupMember grants a role on a group to a given user, replacing any role they held on it
+func (s basicService) SetGroupMember(ctx context.Context, groupId, userId int32, role string) (err error) {
+	if _, err := s.getGroup(groupId); err != nil {
+		return err
+	}
+
+	return s.setMember(ctx, rbac.GroupResource, groupId, userId, role)
+}
+
+// RemoveGroupMember revokes the role a given user holds on a group
+func (s basicService) RemoveGroupMember(ctx context.Context, groupId, userId int32) (err error) {
+	return s.removeMember(rbac.GroupResource, groupId, userId)
+}
+
+// getGroup obtains a given group
+func (s basicService) getGroup(groupId int32) (*user_service.GroupORM, error) {
+	err, group := s.database.GetGroupById(groupId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, helper.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// setMember grants a role on a team or group to a given user
+func (s basicService) setMember(ctx context.Context, resourceType string, resourceId int32, userId int32, role string) error {
+	if !rbac.IsKnownRole(role) {
+		s.logger.Error(helper.ErrInvalidRole.Error())
+		return helper.ErrInvalidRole
+	}
+
+	err, _ := s.database.GetUserById(ctx, userId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrNotFound
+		}
+		return err
+	}
+
+	err = s.database.SetMembership(database.MembershipORM{ResourceType: resourceType, ResourceId: resourceId,
+		UserId: userId, Role: role})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Role granted", zap.String("resource_type", resourceType), zap.Int32("resource_id", resourceId),
+		zap.Int32("user_id", userId), zap.String("role", role))
+	return nil
+}
+
+// removeMember revokes the role a given user holds on a team or group
+func (s basicService) removeMember(resourceType string, resourceId int32, userId int32) error {
+	if err := s.database.RemoveMembership(resourceType, resourceId, userId); err != nil {
+		return err
+	}
+
+	s.logger.Info("Role revoked", zap.String("resource_type", resourceType), zap.Int32("resource_id", resourceId),
+		zap.Int32("user_id", userId))
+	return nil
+}
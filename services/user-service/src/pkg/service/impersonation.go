@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// Impersonate issues a time boxed access token letting the authenticated staff member act on behalf of
+// a given user. The start of the impersonation is audited before the token is issued
+func (s basicService) Impersonate(ctx context.Context, userId int32) (token auth.TokenPair, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || !principal.IsFirstParty() || !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return token, helper.ErrForbidden
+	}
+
+	if principal.UserId == userId {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return token, helper.ErrInvalidArgumentProvided
+	}
+
+	err, user := s.database.GetUserById(ctx, userId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return token, helper.ErrNotFound
+		}
+		return token, err
+	}
+
+	// staff accounts are never impersonated so that impersonation tokens never grant the admin scope
+	if user.UserAccountType == auth.AdminAccountType {
+		s.logger.Error(helper.ErrForbidden.Error(), zap.Int32("subject_id", userId))
+		return token, helper.ErrForbidden
+	}
+
+	client := auth.ClientFromContext(ctx)
+	err = s.database.CreateAuditRecord(database.AuditRecordORM{
+		ActorId:   principal.UserId,
+		SubjectId: user.Id,
+		AccountID: user.AccountID,
+		Operation: "Impersonate",
+		Outcome:   auditSucceeded,
+		IpAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+	})
+	if err != nil {
+		return token, err
+	}
+
+	token, err = s.tokens.IssueImpersonationToken(*user, principal.UserId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return auth.TokenPair{}, err
+	}
+
+	s.logger.Info("Impersonation started", zap.Int32("actor_id", principal.UserId), zap.Int32("subject_id", user.Id))
+	return token, nil
+}
+
+// An authorizing wrapper around the ChangePassword service implementation
+func (mw authorizationMiddleware) ChangePassword(ctx context.Context, currentPassword, password, passwordConfirmed string) (err error) {
+	if err := mw.rejectImpersonation(ctx, "ChangePassword"); err != nil {
+		return err
+	}
+	return mw.Service.ChangePassword(ctx, currentPassword, password, passwordConfirmed)
+}
+
+// An authorizing wrapper around the ChangeEmail service implementation
+func (mw authorizationMiddleware) ChangeEmail(ctx context.Context, email string) (err error) {
+	if err := mw.rejectImpersonation(ctx, "ChangeEmail"); err != nil {
+		return err
+	}
+	return mw.Service.ChangeEmail(ctx, email)
+}
+
+// An authorizing wrapper around the EnrollTwoFactor service implementation
+func (mw authorizationMiddleware) EnrollTwoFactor(ctx context.Context) (secret string, uri string, err error) {
+	if err := mw.rejectImpersonation(ctx, "EnrollTwoFactor"); err != nil {
+		return "", "", err
+	}
+	return mw.Service.EnrollTwoFactor(ctx)
+}
+
+// An authorizing wrapper around the ConfirmTwoFactor service implementation
+func (mw authorizationMiddleware) ConfirmTwoFactor(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	if err := mw.rejectImpersonation(ctx, "ConfirmTwoFactor"); err != nil {
+		return nil, err
+	}
+	return mw.Service.ConfirmTwoFactor(ctx, code)
+}
+
+// An authorizing wrapper around the DisableTwoFactor service implementation
+func (mw authorizationMiddleware) DisableTwoFactor(ctx context.Context, code string) (err error) {
+	if err := mw.rejectImpersonation(ctx, "DisableTwoFactor"); err != nil {
+		return err
+	}
+	return mw.Service.DisableTwoFactor(ctx, code)
+}
+
+// An authorizing wrapper around the CreateAPIKey service implementation
+func (mw authorizationMiddleware) CreateAPIKey(ctx context.Context, name string, teamId int32, scopes []string,
+	expiresIn time.Duration) (key string, apiKey database.APIKeyORM, err error) {
+	if err := mw.rejectImpersonation(ctx, "CreateAPIKey"); err != nil {
+		return "", database.APIKeyORM{}, err
+	}
+	return mw.Service.CreateAPIKey(ctx, name, teamId, scopes, expiresIn)
+}
+
+// An authorizing wrapper around the Authorize service implementation
+func (mw authorizationMiddleware) Authorize(ctx context.Context, req auth.AuthorizationRequest, grantConsent bool) (redirectURI string, err error) {
+	if err := mw.rejectImpersonation(ctx, "Authorize"); err != nil {
+		return "", err
+	}
+	return mw.Service.Authorize(ctx, req, grantConsent)
+}
+
+// rejectImpersonation fails if the authenticated principal is impersonated. Staff members acting on
+// behalf of a user may neither change the credentials of the user nor mint credentials outliving
+// the impersonation
+func (mw authorizationMiddleware) rejectImpersonation(ctx context.Context, operation string) error {
+	principal, ok := auth.FromContext(ctx)
+	if ok && principal.IsImpersonated() {
+		mw.logger.Error(helper.ErrImpersonationForbidden.Error(), zap.String("operation", operation),
+			zap.Int32("actor_id", principal.ActorId), zap.Int32("subject_id", principal.UserId))
+		return helper.ErrImpersonationForbidden
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// ListUsers returns a page of the users of the account of the caller matching a query alongside the
+// cursor of the next page. Users are projected onto their public fields
+func (s basicService) ListUsers(ctx context.Context, query database.ListQuery, cursor string) (users []*user_service.UserORM, nextCursor string, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, "", helper.ErrUnauthorized
+	}
+
+	query, limit, err := prepareListQuery(query, cursor)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, "", err
+	}
+
+	err, users = s.database.ListUsers(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(users) > limit {
+		users = users[:limit]
+		last := users[limit-1]
+		nextCursor = helper.EncodeCursor(query.Cursor(last.Id, last.CreatedAt, last.UserName))
+	}
+
+	for i, user := range users {
+		projected := publicUser(principal, *user)
+		users[i] = &projected
+	}
+
+	return users, nextCursor, nil
+}
+
+// ListTeams returns a page of the teams matching a query alongside the cursor of the next page
+func (s basicService) ListTeams(ctx context.Context, query database.ListQuery, cursor string) (teams []*user_service.TeamORM, nextCursor string, err error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, "", helper.ErrUnauthorized
+	}
+
+	query, limit, err := prepareListQuery(query, cursor)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, "", err
+	}
+
+	err, teams = s.database.ListTeams(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(teams) > limit {
+		teams = teams[:limit]
+		last := teams[limit-1]
+		nextCursor = helper.EncodeCursor(query.Cursor(last.Id, last.CreatedAt, last.Name))
+	}
+
+	for i, team := range teams {
+		sanitized := sanitizeTeam(*team)
+		teams[i] = &sanitized
+	}
+
+	return teams, nextCursor, nil
+}
+
+// ListGroups returns a page of the groups matching a query alongside the cursor of the next page
+func (s basicService) ListGroups(ctx context.Context, query database.ListQuery, cursor string) (groups []*user_service.GroupORM, nextCursor string, err error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, "", helper.ErrUnauthorized
+	}
+
+	query, limit, err := prepareListQuery(query, cursor)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, "", err
+	}
+
+	err, groups = s.database.ListGroups(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(groups) > limit {
+		groups = groups[:limit]
+		last := groups[limit-1]
+		nextCursor = helper.EncodeCursor(query.Cursor(last.Id, last.CreatedAt, last.Name))
+	}
+
+	return groups, nextCursor, nil
+}
+
+// prepareListQuery decodes the cursor of a listing, defaults its sort key and bounds its page size.
+// One extra record is requested to find out whether a next page exists
+func prepareListQuery(query database.ListQuery, cursor string) (database.ListQuery, int, error) {
+	after, err := helper.DecodeCursor(cursor)
+	if err != nil {
+		return query, 0, err
+	}
+
+	if query.SortBy == "" {
+		query.SortBy = database.SortById
+	}
+
+	limit := helper.PageSize(query.Limit)
+	query.After = after
+	query.Limit = limit + 1
+
+	return query, limit, query.Validate()
+}
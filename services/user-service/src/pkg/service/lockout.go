@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+const (
+	// usernameThrottleScope scopes failure counters to the username a log in was attempted for
+	usernameThrottleScope = "username"
+	// ipThrottleScope scopes failure counters to the address log in attempts originate from
+	ipThrottleScope = "ip"
+)
+
+// Outcomes of log in attempts recorded as login activity
+const (
+	loginSucceeded          = "success"
+	loginChallenged         = "two_factor_challenge"
+	loginInvalidCredentials = "invalid_credentials"
+	loginInvalidTwoFactor   = "invalid_two_factor_code"
+	loginNotVerified        = "not_verified"
+	loginThrottled          = "throttled"
+	loginLocked             = "locked"
+	loginFailed             = "error"
+)
+
+// loginThrottle identifies a failure counter as well as the amount of failures locking it
+type loginThrottle struct {
+	scope      string
+	identifier string
+	threshold  int
+}
+
+// loginThrottles returns the failure counters a log in attempt is subject to
+func loginThrottles(username string, client auth.Client) []loginThrottle {
+	throttles := []loginThrottle{{
+		scope:      usernameThrottleScope,
+		identifier: strings.ToLower(username),
+		threshold:  config.Config.LoginLockout,
+	}}
+
+	if client.IPAddress != "" {
+		throttles = append(throttles, loginThrottle{
+			scope:      ipThrottleScope,
+			identifier: client.IPAddress,
+			threshold:  config.Config.IPLockout,
+		})
+	}
+
+	return throttles
+}
+
+// UnlockUser clears the failed log in attempts and any lock of the account of a given user
+func (s basicService) UnlockUser(ctx context.Context, id int32) (err error) {
+	principal, _ := auth.FromContext(ctx)
+
+	err, user := s.database.GetUserById(ctx, id)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrNotFound
+		}
+		return err
+	}
+
+	if err := s.database.ResetLoginThrottle(usernameThrottleScope, strings.ToLower(user.UserName)); err != nil {
+		return err
+	}
+
+	s.logger.Info("User unlocked", zap.Int32("user_id", id), zap.Int32("unlocked_by", principal.UserId))
+	return nil
+}
+
+// checkLoginThrottles rejects log in attempts made while a failure counter is locked or before the
+// backoff delay following the last failure elapsed
+func (s basicService) checkLoginThrottles(throttles []loginThrottle) error {
+	now := time.Now()
+	for _, throttle := range throttles {
+		err, current := s.database.GetLoginThrottle(throttle.scope, throttle.identifier)
+		if err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if current.LockedUntil != nil && now.Before(*current.LockedUntil) {
+			s.logger.Error(helper.ErrLoginLocked.Error(), zap.String("scope", throttle.scope))
+			return helper.ErrLoginLocked
+		}
+
+		if current.LastFailureAt == nil || now.Sub(*current.LastFailureAt) > config.Config.LockoutDuration {
+			continue
+		}
+
+		if now.Before(current.LastFailureAt.Add(loginBackoff(int(current.Failures), throttle.threshold))) {
+			s.logger.Error(helper.ErrTooManyLoginAttempts.Error(), zap.String("scope", throttle.scope))
+			return helper.ErrTooManyLoginAttempts
+		}
+	}
+
+	return nil
+}
+
+// recordLoginFailure increments the failure counters of a failed log in attempt and locks the
+// counters reaching their threshold
+func (s basicService) recordLoginFailure(throttles []loginThrottle) {
+	for _, throttle := range throttles {
+		err, current := s.database.RecordLoginFailure(throttle.scope, throttle.identifier, config.Config.LockoutDuration)
+		if err != nil {
+			continue
+		}
+
+		if int(current.Failures) < throttle.threshold {
+			continue
+		}
+
+		if err := s.database.LockLogin(throttle.scope, throttle.identifier, time.Now().Add(config.Config.LockoutDuration)); err != nil {
+			continue
+		}
+
+		s.lockouts.With("scope", throttle.scope).Add(1)
+		s.logger.Info("Log in locked", zap.String("scope", throttle.scope),
+			zap.String("identifier", throttle.identifier), zap.Int32("failures", current.Failures))
+	}
+}
+
+// resetLoginThrottle clears the failure counter of the username a log in succeeded for. The
+// counter of the ip address is left untouched as a single address may guess many usernames
+func (s basicService) resetLoginThrottle(username string) {
+	_ = s.database.ResetLoginThrottle(usernameThrottleScope, strings.ToLower(username))
+}
+
+// recordLoginActivity persists the outcome of a log in attempt. Failing to record activity does
+// not fail the log in
+func (s basicService) recordLoginActivity(ctx context.Context, userId int32, username string, outcome string) {
+	client := auth.ClientFromContext(ctx)
+	now := time.Now()
+	_ = s.database.CreateLoginActivity(user_service.LoginActivityORM{
+		UserId:    userId,
+		Username:  username,
+		IpAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		Outcome:   outcome,
+		Date:      now.UTC().Format(time.RFC3339),
+		CreatedAt: &now,
+	})
+
+	if outcome == loginSucceeded {
+		_ = s.database.UpdateLastLogin(userId, now, client.IPAddress)
+	}
+}
+
+// loginOutcome maps the error a log in attempt failed with to the outcome recorded for it
+func loginOutcome(err error) string {
+	switch err {
+	case nil:
+		return loginSucceeded
+	case helper.ErrInvalidUsernameProvided, helper.ErrInvalidPasswordProvided:
+		return loginInvalidCredentials
+	case helper.ErrInvalidTwoFactorCode:
+		return loginInvalidTwoFactor
+	case helper.ErrAccountNotVerified:
+		return loginNotVerified
+	case helper.ErrTooManyLoginAttempts:
+		return loginThrottled
+	case helper.ErrLoginLocked:
+		return loginLocked
+	default:
+		return loginFailed
+	}
+}
+
+// loginBackoff returns the delay a new attempt must wait for after a given amount of consecutive
+// failures. The first half of the attempts preceding a lock are free, the delay then doubles with
+// every failure
+func loginBackoff(failures int, threshold int) time.Duration {
+	free := threshold / 2
+	if failures <= free {
+		return 0
+	}
+
+	delay := config.Config.LoginBackoff
+	for i := free + 1; i < failures && delay < config.Config.LockoutDuration; i++ {
+		delay *= 2
+	}
+
+	if delay > config.Config.LockoutDuration {
+		return config.Config.LockoutDuration
+	}
+	return delay
+}
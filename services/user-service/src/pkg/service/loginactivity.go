@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// GetLoginActivity returns a page of the log in attempts made for a given user, most recent first.
+// Users may only review their own activity unless they are platform staff
+func (s basicService) GetLoginActivity(ctx context.Context, id int32, pageSize int, pageToken string) (activities []*user_service.LoginActivityORM, nextPageToken string, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || principal.UserId == 0 {
+		return nil, "", helper.ErrUnauthorized
+	}
+
+	if principal.UserId != id && !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return nil, "", helper.ErrForbidden
+	}
+
+	beforeId, err := helper.DecodePageToken(pageToken)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, "", err
+	}
+
+	// one extra record is fetched to find out whether a next page exists
+	limit := helper.PageSize(pageSize)
+	err, activities = s.database.GetLoginActivity(id, beforeId, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(activities) > limit {
+		activities = activities[:limit]
+		nextPageToken = helper.EncodePageToken(activities[limit-1].Id)
+	}
+
+	return activities, nextPageToken, nil
+}
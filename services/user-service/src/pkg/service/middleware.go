@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/genproto/protobuf/field_mask"
 
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
 	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
 )
 
@@ -25,29 +29,703 @@ type loggingMiddleware struct {
 	next   Service
 }
 
+// requestLogger returns a logger annotated with the staff member and the user behind a request made
+// while impersonating that user
+func (mw loggingMiddleware) requestLogger(ctx context.Context) *zap.Logger {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || !principal.IsImpersonated() {
+		return mw.logger
+	}
+	return mw.logger.With(zap.Int32("actor_id", principal.ActorId), zap.Int32("subject_id", principal.UserId))
+}
+
 // A logging wrapper around the LogIn service implementation
-func (mw loggingMiddleware) LogIn(ctx context.Context, username, password string) (user user_service.UserORM, err error) {
+func (mw loggingMiddleware) LogIn(ctx context.Context, username, password string) (user user_service.UserORM, token auth.TokenPair, challenge string, err error) {
 	defer func() {
 		if err != nil {
-			mw.logger.Info("Request Completed",
+			mw.requestLogger(ctx).Info("Request Completed",
 				zap.String("method", "LogIn"),
-				zap.Any("user", user), zap.Any("error", err))
+				zap.String("username", username), zap.Any("error", err))
 		}
 	}()
 
-	user, err = mw.next.LogIn(ctx, username, password)
+	user, token, challenge, err = mw.next.LogIn(ctx, username, password)
 
 	if err != nil {
-		return user_service.UserORM{}, err
+		return user_service.UserORM{}, auth.TokenPair{}, "", err
 	}
-	return user, nil
+	return user, token, challenge, nil
+}
+
+// A logging wrapper around the RefreshToken service implementation
+func (mw loggingMiddleware) RefreshToken(ctx context.Context, refreshToken string) (token auth.TokenPair, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RefreshToken"), zap.Any("error", err))
+		}
+	}()
+
+	token, err = mw.next.RefreshToken(ctx, refreshToken)
+
+	if err != nil {
+		return auth.TokenPair{}, err
+	}
+	return token, nil
+}
+
+// A logging wrapper around the LogOut service implementation
+func (mw loggingMiddleware) LogOut(ctx context.Context, refreshToken string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "LogOut"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.LogOut(ctx, refreshToken)
+}
+
+// A logging wrapper around the CreateAPIKey service implementation
+func (mw loggingMiddleware) CreateAPIKey(ctx context.Context, name string, teamId int32, scopes []string,
+	expiresIn time.Duration) (key string, apiKey database.APIKeyORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "CreateAPIKey"),
+				zap.String("name", name), zap.Int32("team", teamId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.CreateAPIKey(ctx, name, teamId, scopes, expiresIn)
+}
+
+// A logging wrapper around the ListAPIKeys service implementation
+func (mw loggingMiddleware) ListAPIKeys(ctx context.Context, teamId int32) (keys []*database.APIKeyORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ListAPIKeys"),
+				zap.Int32("team", teamId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ListAPIKeys(ctx, teamId)
+}
+
+// A logging wrapper around the RevokeAPIKey service implementation
+func (mw loggingMiddleware) RevokeAPIKey(ctx context.Context, id int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RevokeAPIKey"),
+				zap.Int32("id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RevokeAPIKey(ctx, id)
+}
+
+// A logging wrapper around the RegisterOAuthClient service implementation
+func (mw loggingMiddleware) RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes []string,
+	public bool) (secret string, client database.OAuthClientORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RegisterOAuthClient"),
+				zap.String("name", name), zap.Strings("redirect_uris", redirectURIs), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RegisterOAuthClient(ctx, name, redirectURIs, scopes, public)
+}
+
+// A logging wrapper around the Authorize service implementation
+func (mw loggingMiddleware) Authorize(ctx context.Context, req auth.AuthorizationRequest, grantConsent bool) (redirectURI string, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "Authorize"),
+				zap.String("client_id", req.ClientId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.Authorize(ctx, req, grantConsent)
+}
+
+// A logging wrapper around the ExchangeToken service implementation
+func (mw loggingMiddleware) ExchangeToken(ctx context.Context, req auth.TokenRequest) (token auth.TokenPair, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ExchangeToken"),
+				zap.String("client_id", req.ClientId), zap.String("grant_type", req.GrantType), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ExchangeToken(ctx, req)
+}
+
+// A logging wrapper around the GetOpenIDConfiguration service implementation
+func (mw loggingMiddleware) GetOpenIDConfiguration(ctx context.Context) (configuration auth.OpenIDConfiguration, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "GetOpenIDConfiguration"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.GetOpenIDConfiguration(ctx)
+}
+
+// A logging wrapper around the GetJSONWebKeySet service implementation
+func (mw loggingMiddleware) GetJSONWebKeySet(ctx context.Context) (keys auth.JSONWebKeySet, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "GetJSONWebKeySet"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.GetJSONWebKeySet(ctx)
+}
+
+// A logging wrapper around the ForgotPassword service implementation
+func (mw loggingMiddleware) ForgotPassword(ctx context.Context, email string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ForgotPassword"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ForgotPassword(ctx, email)
+}
+
+// A logging wrapper around the ResetPassword service implementation
+func (mw loggingMiddleware) ResetPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ResetPassword"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ResetPassword(ctx, token, password, passwordConfirmed)
+}
+
+// A logging wrapper around the ChangeEmail service implementation
+func (mw loggingMiddleware) ChangeEmail(ctx context.Context, email string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ChangeEmail"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ChangeEmail(ctx, email)
+}
+
+// A logging wrapper around the ConfirmEmailChange service implementation
+func (mw loggingMiddleware) ConfirmEmailChange(ctx context.Context, token string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ConfirmEmailChange"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ConfirmEmailChange(ctx, token)
+}
+
+// A logging wrapper around the VerifyEmail service implementation
+func (mw loggingMiddleware) VerifyEmail(ctx context.Context, token string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "VerifyEmail"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.VerifyEmail(ctx, token)
+}
+
+// A logging wrapper around the ResendVerification service implementation
+func (mw loggingMiddleware) ResendVerification(ctx context.Context, email string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ResendVerification"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ResendVerification(ctx, email)
+}
+
+// A logging wrapper around the EnrollTwoFactor service implementation
+func (mw loggingMiddleware) EnrollTwoFactor(ctx context.Context) (secret string, uri string, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "EnrollTwoFactor"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.EnrollTwoFactor(ctx)
+}
+
+// A logging wrapper around the ConfirmTwoFactor service implementation
+func (mw loggingMiddleware) ConfirmTwoFactor(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ConfirmTwoFactor"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ConfirmTwoFactor(ctx, code)
+}
+
+// A logging wrapper around the DisableTwoFactor service implementation
+func (mw loggingMiddleware) DisableTwoFactor(ctx context.Context, code string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "DisableTwoFactor"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.DisableTwoFactor(ctx, code)
+}
+
+// A logging wrapper around the VerifyTwoFactor service implementation
+func (mw loggingMiddleware) VerifyTwoFactor(ctx context.Context, challenge, code string) (user user_service.UserORM, token auth.TokenPair, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "VerifyTwoFactor"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.VerifyTwoFactor(ctx, challenge, code)
+}
+
+// A logging wrapper around the UnlockUser service implementation
+func (mw loggingMiddleware) UnlockUser(ctx context.Context, id int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "UnlockUser"),
+				zap.Int32("user_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.UnlockUser(ctx, id)
+}
+
+// A logging wrapper around the Impersonate service implementation
+func (mw loggingMiddleware) Impersonate(ctx context.Context, userId int32) (token auth.TokenPair, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "Impersonate"),
+				zap.Int32("subject_id", userId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.Impersonate(ctx, userId)
+}
+
+// A logging wrapper around the GetLoginActivity service implementation
+func (mw loggingMiddleware) GetLoginActivity(ctx context.Context, id int32, pageSize int, pageToken string) (activities []*user_service.LoginActivityORM, nextPageToken string, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "GetLoginActivity"),
+				zap.Int32("user_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.GetLoginActivity(ctx, id, pageSize, pageToken)
+}
+
+// A logging wrapper around the ChangePassword service implementation
+func (mw loggingMiddleware) ChangePassword(ctx context.Context, currentPassword, password, passwordConfirmed string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ChangePassword"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ChangePassword(ctx, currentPassword, password, passwordConfirmed)
+}
+
+// A logging wrapper around the ListSessions service implementation
+func (mw loggingMiddleware) ListSessions(ctx context.Context) (sessions []*database.SessionORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ListSessions"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ListSessions(ctx)
+}
+
+// A logging wrapper around the RevokeSession service implementation
+func (mw loggingMiddleware) RevokeSession(ctx context.Context, id int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RevokeSession"),
+				zap.Int32("session_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RevokeSession(ctx, id)
+}
+
+// A logging wrapper around the RevokeAllSessions service implementation
+func (mw loggingMiddleware) RevokeAllSessions(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RevokeAllSessions"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RevokeAllSessions(ctx)
+}
+
+// A logging wrapper around the CreateTeam service implementation
+func (mw loggingMiddleware) CreateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "CreateTeam"),
+				zap.String("team_name", team.Name), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.CreateTeam(ctx, team)
+}
+
+// A logging wrapper around the UpdateTeam service implementation
+func (mw loggingMiddleware) UpdateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "UpdateTeam"),
+				zap.Int32("team_id", team.Id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.UpdateTeam(ctx, team)
+}
+
+// A logging wrapper around the ChangeTeamCredentials service implementation
+func (mw loggingMiddleware) ChangeTeamCredentials(ctx context.Context, id int32, currentPassword, email, password, passwordConfirmed string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ChangeTeamCredentials"),
+				zap.Int32("team_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ChangeTeamCredentials(ctx, id, currentPassword, email, password, passwordConfirmed)
+}
+
+// A logging wrapper around the TeamLogIn service implementation
+func (mw loggingMiddleware) TeamLogIn(ctx context.Context, name, password string) (team user_service.TeamORM, token auth.TokenPair, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "TeamLogIn"),
+				zap.String("team_name", name), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.TeamLogIn(ctx, name, password)
+}
+
+// A logging wrapper around the ForgotTeamPassword service implementation
+func (mw loggingMiddleware) ForgotTeamPassword(ctx context.Context, email string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ForgotTeamPassword"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ForgotTeamPassword(ctx, email)
+}
+
+// A logging wrapper around the ResetTeamPassword service implementation
+func (mw loggingMiddleware) ResetTeamPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ResetTeamPassword"), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ResetTeamPassword(ctx, token, password, passwordConfirmed)
+}
+
+// A logging wrapper around the DeleteTeam service implementation
+func (mw loggingMiddleware) DeleteTeam(ctx context.Context, id int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "DeleteTeam"),
+				zap.Int32("team_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.DeleteTeam(ctx, id)
+}
+
+// A logging wrapper around the SetTeamMember service implementation
+func (mw loggingMiddleware) SetTeamMember(ctx context.Context, teamId, userId int32, role string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "SetTeamMember"),
+				zap.Int32("team_id", teamId), zap.Int32("user_id", userId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.SetTeamMember(ctx, teamId, userId, role)
+}
+
+// A logging wrapper around the RemoveTeamMember service implementation
+func (mw loggingMiddleware) RemoveTeamMember(ctx context.Context, teamId, userId int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RemoveTeamMember"),
+				zap.Int32("team_id", teamId), zap.Int32("user_id", userId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RemoveTeamMember(ctx, teamId, userId)
+}
+
+// A logging wrapper around the CreateGroup service implementation
+func (mw loggingMiddleware) CreateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "CreateGroup"),
+				zap.String("group_name", group.Name), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.CreateGroup(ctx, group)
+}
+
+// A logging wrapper around the UpdateGroup service implementation
+func (mw loggingMiddleware) UpdateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "UpdateGroup"),
+				zap.Int32("group_id", group.Id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.UpdateGroup(ctx, group)
+}
+
+// A logging wrapper around the DeleteGroup service implementation
+func (mw loggingMiddleware) DeleteGroup(ctx context.Context, id int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "DeleteGroup"),
+				zap.Int32("group_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.DeleteGroup(ctx, id)
+}
+
+// A logging wrapper around the SetGroupMember service implementation
+func (mw loggingMiddleware) SetGroupMember(ctx context.Context, groupId, userId int32, role string) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "SetGroupMember"),
+				zap.Int32("group_id", groupId), zap.Int32("user_id", userId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.SetGroupMember(ctx, groupId, userId, role)
+}
+
+// A logging wrapper around the RemoveGroupMember service implementation
+func (mw loggingMiddleware) RemoveGroupMember(ctx context.Context, groupId, userId int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RemoveGroupMember"),
+				zap.Int32("group_id", groupId), zap.Int32("user_id", userId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RemoveGroupMember(ctx, groupId, userId)
+}
+
+// A logging wrapper around the UpdateUser service implementation
+func (mw loggingMiddleware) UpdateUser(ctx context.Context, user user_service.UserORM, updateMask *field_mask.FieldMask) (updated user_service.UserORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "UpdateUser"),
+				zap.Int32("user_id", user.Id), zap.Strings("paths", updateMask.GetPaths()), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.UpdateUser(ctx, user, updateMask)
+}
+
+// A logging wrapper around the DeleteUser service implementation
+func (mw loggingMiddleware) DeleteUser(ctx context.Context, id int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "DeleteUser"),
+				zap.Int32("user_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.DeleteUser(ctx, id)
+}
+
+// A logging wrapper around the RestoreUser service implementation
+func (mw loggingMiddleware) RestoreUser(ctx context.Context, id int32) (err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RestoreUser"),
+				zap.Int32("user_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RestoreUser(ctx, id)
+}
+
+// A logging wrapper around the RequestDataExport service implementation
+func (mw loggingMiddleware) RequestDataExport(ctx context.Context, id int32) (export database.DataExportORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "RequestDataExport"),
+				zap.Int32("user_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.RequestDataExport(ctx, id)
+}
+
+// A logging wrapper around the DownloadDataExport service implementation
+func (mw loggingMiddleware) DownloadDataExport(ctx context.Context, id int32, exportId int32) (archive []byte, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "DownloadDataExport"),
+				zap.Int32("user_id", id), zap.Int32("export_id", exportId), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.DownloadDataExport(ctx, id, exportId)
+}
+
+// A logging wrapper around the EraseUser service implementation
+func (mw loggingMiddleware) EraseUser(ctx context.Context, id int32) (receipt database.ErasureReceiptORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "EraseUser"),
+				zap.Int32("user_id", id), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.EraseUser(ctx, id)
+}
+
+// A logging wrapper around the VerifyErasureReceipt service implementation
+func (mw loggingMiddleware) VerifyErasureReceipt(ctx context.Context, receipt string) (verified database.ErasureReceiptORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "VerifyErasureReceipt"),
+				zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.VerifyErasureReceipt(ctx, receipt)
+}
+
+// A logging wrapper around the ListUsers service implementation
+func (mw loggingMiddleware) ListUsers(ctx context.Context, query database.ListQuery, cursor string) (users []*user_service.UserORM, nextCursor string, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ListUsers"),
+				zap.String("sort_by", query.SortBy), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ListUsers(ctx, query, cursor)
+}
+
+// A logging wrapper around the ListTeams service implementation
+func (mw loggingMiddleware) ListTeams(ctx context.Context, query database.ListQuery, cursor string) (teams []*user_service.TeamORM, nextCursor string, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ListTeams"),
+				zap.String("sort_by", query.SortBy), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ListTeams(ctx, query, cursor)
+}
+
+// A logging wrapper around the ListGroups service implementation
+func (mw loggingMiddleware) ListGroups(ctx context.Context, query database.ListQuery, cursor string) (groups []*user_service.GroupORM, nextCursor string, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "ListGroups"),
+				zap.String("sort_by", query.SortBy), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.ListGroups(ctx, query, cursor)
+}
+
+// A logging wrapper around the GetUsersByType service implementation
+func (mw loggingMiddleware) GetUsersByType(ctx context.Context, query string, maxResults int32, accountType user_service.AccountType) (users []*user_service.UserORM, err error) {
+	defer func() {
+		if err != nil {
+			mw.requestLogger(ctx).Info("Request Completed",
+				zap.String("method", "GetUsersByType"),
+				zap.String("account_type", accountType.String()), zap.Any("error", err))
+		}
+	}()
+
+	return mw.next.GetUsersByType(ctx, query, maxResults, accountType)
 }
 
 // A logging wrapper around the GetUserById service implementation
 func (mw loggingMiddleware) GetUserById(ctx context.Context, id string) (user user_service.UserORM, err error) {
 	defer func() {
 		if err != nil {
-			mw.logger.Info("Request Completed",
+			mw.requestLogger(ctx).Info("Request Completed",
 				zap.String("method", "GetUserById"),
 				zap.Any("user", user), zap.Any("error", err))
 		}
@@ -65,7 +743,7 @@ func (mw loggingMiddleware) GetUserById(ctx context.Context, id string) (user us
 func (mw loggingMiddleware) GetUserByEmail(ctx context.Context, email string) (user user_service.UserORM, err error) {
 	defer func() {
 		if err != nil {
-			mw.logger.Info("Request Completed",
+			mw.requestLogger(ctx).Info("Request Completed",
 				zap.String("method", "GetUserByEmail"),
 				zap.Any("user", user), zap.Any("error", err))
 		}
@@ -83,7 +761,7 @@ func (mw loggingMiddleware) GetUserByEmail(ctx context.Context, email string) (u
 func (mw loggingMiddleware) GetUserByUsername(ctx context.Context, username string) (user user_service.UserORM, err error) {
 	defer func() {
 		if err != nil {
-			mw.logger.Info("Request Completed",
+			mw.requestLogger(ctx).Info("Request Completed",
 				zap.String("method", "GetUserByUsername"),
 				zap.Any("user", user), zap.Any("error", err))
 		}
@@ -101,7 +779,7 @@ func (mw loggingMiddleware) GetUserByUsername(ctx context.Context, username stri
 func (mw loggingMiddleware) CreateUser(ctx context.Context, user user_service.UserORM) (err error) {
 	defer func() {
 		if err != nil {
-			mw.logger.Info("Request Completed",
+			mw.requestLogger(ctx).Info("Request Completed",
 				zap.String("method", "CreateUser"),
 				zap.Any("user", user), zap.Any("error", err))
 		}
@@ -142,16 +820,278 @@ type instrumentingMiddleware struct {
 }
 
 // An instrumenting wrapper around the LogIn service implementation
-func (mw instrumentingMiddleware) LogIn(ctx context.Context, username, password string) (user user_service.UserORM, err error) {
-	user, err = mw.next.LogIn(ctx, username, password)
+func (mw instrumentingMiddleware) LogIn(ctx context.Context, username, password string) (user user_service.UserORM, token auth.TokenPair, challenge string, err error) {
+	user, token, challenge, err = mw.next.LogIn(ctx, username, password)
 
 	if err != nil {
 		mw.FailedLogInRequest.Add(1)
-		return user_service.UserORM{}, err
+		return user_service.UserORM{}, auth.TokenPair{}, "", err
 	}
 
 	mw.SuccessfulLogInRequest.Add(1)
-	return user, nil
+	return user, token, challenge, nil
+}
+
+// An instrumenting wrapper around the RefreshToken service implementation
+func (mw instrumentingMiddleware) RefreshToken(ctx context.Context, refreshToken string) (token auth.TokenPair, err error) {
+	return mw.next.RefreshToken(ctx, refreshToken)
+}
+
+// An instrumenting wrapper around the LogOut service implementation
+func (mw instrumentingMiddleware) LogOut(ctx context.Context, refreshToken string) (err error) {
+	return mw.next.LogOut(ctx, refreshToken)
+}
+
+// An instrumenting wrapper around the CreateAPIKey service implementation
+func (mw instrumentingMiddleware) CreateAPIKey(ctx context.Context, name string, teamId int32, scopes []string,
+	expiresIn time.Duration) (key string, apiKey database.APIKeyORM, err error) {
+	return mw.next.CreateAPIKey(ctx, name, teamId, scopes, expiresIn)
+}
+
+// An instrumenting wrapper around the ListAPIKeys service implementation
+func (mw instrumentingMiddleware) ListAPIKeys(ctx context.Context, teamId int32) (keys []*database.APIKeyORM, err error) {
+	return mw.next.ListAPIKeys(ctx, teamId)
+}
+
+// An instrumenting wrapper around the RevokeAPIKey service implementation
+func (mw instrumentingMiddleware) RevokeAPIKey(ctx context.Context, id int32) (err error) {
+	return mw.next.RevokeAPIKey(ctx, id)
+}
+
+// An instrumenting wrapper around the RegisterOAuthClient service implementation
+func (mw instrumentingMiddleware) RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes []string,
+	public bool) (secret string, client database.OAuthClientORM, err error) {
+	return mw.next.RegisterOAuthClient(ctx, name, redirectURIs, scopes, public)
+}
+
+// An instrumenting wrapper around the Authorize service implementation
+func (mw instrumentingMiddleware) Authorize(ctx context.Context, req auth.AuthorizationRequest, grantConsent bool) (redirectURI string, err error) {
+	return mw.next.Authorize(ctx, req, grantConsent)
+}
+
+// An instrumenting wrapper around the ExchangeToken service implementation
+func (mw instrumentingMiddleware) ExchangeToken(ctx context.Context, req auth.TokenRequest) (token auth.TokenPair, err error) {
+	return mw.next.ExchangeToken(ctx, req)
+}
+
+// An instrumenting wrapper around the GetOpenIDConfiguration service implementation
+func (mw instrumentingMiddleware) GetOpenIDConfiguration(ctx context.Context) (configuration auth.OpenIDConfiguration, err error) {
+	return mw.next.GetOpenIDConfiguration(ctx)
+}
+
+// An instrumenting wrapper around the GetJSONWebKeySet service implementation
+func (mw instrumentingMiddleware) GetJSONWebKeySet(ctx context.Context) (keys auth.JSONWebKeySet, err error) {
+	return mw.next.GetJSONWebKeySet(ctx)
+}
+
+// An instrumenting wrapper around the ForgotPassword service implementation
+func (mw instrumentingMiddleware) ForgotPassword(ctx context.Context, email string) (err error) {
+	return mw.next.ForgotPassword(ctx, email)
+}
+
+// An instrumenting wrapper around the ResetPassword service implementation
+func (mw instrumentingMiddleware) ResetPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	return mw.next.ResetPassword(ctx, token, password, passwordConfirmed)
+}
+
+// An instrumenting wrapper around the ChangeEmail service implementation
+func (mw instrumentingMiddleware) ChangeEmail(ctx context.Context, email string) (err error) {
+	return mw.next.ChangeEmail(ctx, email)
+}
+
+// An instrumenting wrapper around the ConfirmEmailChange service implementation
+func (mw instrumentingMiddleware) ConfirmEmailChange(ctx context.Context, token string) (err error) {
+	return mw.next.ConfirmEmailChange(ctx, token)
+}
+
+// An instrumenting wrapper around the VerifyEmail service implementation
+func (mw instrumentingMiddleware) VerifyEmail(ctx context.Context, token string) (err error) {
+	return mw.next.VerifyEmail(ctx, token)
+}
+
+// An instrumenting wrapper around the ResendVerification service implementation
+func (mw instrumentingMiddleware) ResendVerification(ctx context.Context, email string) (err error) {
+	return mw.next.ResendVerification(ctx, email)
+}
+
+// An instrumenting wrapper around the EnrollTwoFactor service implementation
+func (mw instrumentingMiddleware) EnrollTwoFactor(ctx context.Context) (secret string, uri string, err error) {
+	return mw.next.EnrollTwoFactor(ctx)
+}
+
+// An instrumenting wrapper around the ConfirmTwoFactor service implementation
+func (mw instrumentingMiddleware) ConfirmTwoFactor(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	return mw.next.ConfirmTwoFactor(ctx, code)
+}
+
+// An instrumenting wrapper around the DisableTwoFactor service implementation
+func (mw instrumentingMiddleware) DisableTwoFactor(ctx context.Context, code string) (err error) {
+	return mw.next.DisableTwoFactor(ctx, code)
+}
+
+// An instrumenting wrapper around the VerifyTwoFactor service implementation
+func (mw instrumentingMiddleware) VerifyTwoFactor(ctx context.Context, challenge, code string) (user user_service.UserORM, token auth.TokenPair, err error) {
+	return mw.next.VerifyTwoFactor(ctx, challenge, code)
+}
+
+// An instrumenting wrapper around the UnlockUser service implementation
+func (mw instrumentingMiddleware) UnlockUser(ctx context.Context, id int32) (err error) {
+	return mw.next.UnlockUser(ctx, id)
+}
+
+// An instrumenting wrapper around the Impersonate service implementation
+func (mw instrumentingMiddleware) Impersonate(ctx context.Context, userId int32) (token auth.TokenPair, err error) {
+	return mw.next.Impersonate(ctx, userId)
+}
+
+// An instrumenting wrapper around the GetLoginActivity service implementation
+func (mw instrumentingMiddleware) GetLoginActivity(ctx context.Context, id int32, pageSize int, pageToken string) (activities []*user_service.LoginActivityORM, nextPageToken string, err error) {
+	return mw.next.GetLoginActivity(ctx, id, pageSize, pageToken)
+}
+
+// An instrumenting wrapper around the ChangePassword service implementation
+func (mw instrumentingMiddleware) ChangePassword(ctx context.Context, currentPassword, password, passwordConfirmed string) (err error) {
+	return mw.next.ChangePassword(ctx, currentPassword, password, passwordConfirmed)
+}
+
+// An instrumenting wrapper around the ListSessions service implementation
+func (mw instrumentingMiddleware) ListSessions(ctx context.Context) (sessions []*database.SessionORM, err error) {
+	return mw.next.ListSessions(ctx)
+}
+
+// An instrumenting wrapper around the RevokeSession service implementation
+func (mw instrumentingMiddleware) RevokeSession(ctx context.Context, id int32) (err error) {
+	return mw.next.RevokeSession(ctx, id)
+}
+
+// An instrumenting wrapper around the RevokeAllSessions service implementation
+func (mw instrumentingMiddleware) RevokeAllSessions(ctx context.Context) (err error) {
+	return mw.next.RevokeAllSessions(ctx)
+}
+
+// An instrumenting wrapper around the CreateTeam service implementation
+func (mw instrumentingMiddleware) CreateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	return mw.next.CreateTeam(ctx, team)
+}
+
+// An instrumenting wrapper around the UpdateTeam service implementation
+func (mw instrumentingMiddleware) UpdateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	return mw.next.UpdateTeam(ctx, team)
+}
+
+// An instrumenting wrapper around the ChangeTeamCredentials service implementation
+func (mw instrumentingMiddleware) ChangeTeamCredentials(ctx context.Context, id int32, currentPassword, email, password, passwordConfirmed string) (err error) {
+	return mw.next.ChangeTeamCredentials(ctx, id, currentPassword, email, password, passwordConfirmed)
+}
+
+// An instrumenting wrapper around the TeamLogIn service implementation
+func (mw instrumentingMiddleware) TeamLogIn(ctx context.Context, name, password string) (team user_service.TeamORM, token auth.TokenPair, err error) {
+	return mw.next.TeamLogIn(ctx, name, password)
+}
+
+// An instrumenting wrapper around the ForgotTeamPassword service implementation
+func (mw instrumentingMiddleware) ForgotTeamPassword(ctx context.Context, email string) (err error) {
+	return mw.next.ForgotTeamPassword(ctx, email)
+}
+
+// An instrumenting wrapper around the ResetTeamPassword service implementation
+func (mw instrumentingMiddleware) ResetTeamPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	return mw.next.ResetTeamPassword(ctx, token, password, passwordConfirmed)
+}
+
+// An instrumenting wrapper around the DeleteTeam service implementation
+func (mw instrumentingMiddleware) DeleteTeam(ctx context.Context, id int32) (err error) {
+	return mw.next.DeleteTeam(ctx, id)
+}
+
+// An instrumenting wrapper around the SetTeamMember service implementation
+func (mw instrumentingMiddleware) SetTeamMember(ctx context.Context, teamId, userId int32, role string) (err error) {
+	return mw.next.SetTeamMember(ctx, teamId, userId, role)
+}
+
+// An instrumenting wrapper around the RemoveTeamMember service implementation
+func (mw instrumentingMiddleware) RemoveTeamMember(ctx context.Context, teamId, userId int32) (err error) {
+	return mw.next.RemoveTeamMember(ctx, teamId, userId)
+}
+
+// An instrumenting wrapper around the CreateGroup service implementation
+func (mw instrumentingMiddleware) CreateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	return mw.next.CreateGroup(ctx, group)
+}
+
+// An instrumenting wrapper around the UpdateGroup service implementation
+func (mw instrumentingMiddleware) UpdateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	return mw.next.UpdateGroup(ctx, group)
+}
+
+// An instrumenting wrapper around the DeleteGroup service implementation
+func (mw instrumentingMiddleware) DeleteGroup(ctx context.Context, id int32) (err error) {
+	return mw.next.DeleteGroup(ctx, id)
+}
+
+// An instrumenting wrapper around the SetGroupMember service implementation
+func (mw instrumentingMiddleware) SetGroupMember(ctx context.Context, groupId, userId int32, role string) (err error) {
+	return mw.next.SetGroupMember(ctx, groupId, userId, role)
+}
+
+// An instrumenting wrapper around the RemoveGroupMember service implementation
+func (mw instrumentingMiddleware) RemoveGroupMember(ctx context.Context, groupId, userId int32) (err error) {
+	return mw.next.RemoveGroupMember(ctx, groupId, userId)
+}
+
+// An instrumenting wrapper around the UpdateUser service implementation
+func (mw instrumentingMiddleware) UpdateUser(ctx context.Context, user user_service.UserORM, updateMask *field_mask.FieldMask) (updated user_service.UserORM, err error) {
+	return mw.next.UpdateUser(ctx, user, updateMask)
+}
+
+// An instrumenting wrapper around the DeleteUser service implementation
+func (mw instrumentingMiddleware) DeleteUser(ctx context.Context, id int32) (err error) {
+	return mw.next.DeleteUser(ctx, id)
+}
+
+// An instrumenting wrapper around the RestoreUser service implementation
+func (mw instrumentingMiddleware) RestoreUser(ctx context.Context, id int32) (err error) {
+	return mw.next.RestoreUser(ctx, id)
+}
+
+// An instrumenting wrapper around the RequestDataExport service implementation
+func (mw instrumentingMiddleware) RequestDataExport(ctx context.Context, id int32) (export database.DataExportORM, err error) {
+	return mw.next.RequestDataExport(ctx, id)
+}
+
+// An instrumenting wrapper around the DownloadDataExport service implementation
+func (mw instrumentingMiddleware) DownloadDataExport(ctx context.Context, id int32, exportId int32) (archive []byte, err error) {
+	return mw.next.DownloadDataExport(ctx, id, exportId)
+}
+
+// An instrumenting wrapper around the EraseUser service implementation
+func (mw instrumentingMiddleware) EraseUser(ctx context.Context, id int32) (receipt database.ErasureReceiptORM, err error) {
+	return mw.next.EraseUser(ctx, id)
+}
+
+// An instrumenting wrapper around the VerifyErasureReceipt service implementation
+func (mw instrumentingMiddleware) VerifyErasureReceipt(ctx context.Context, receipt string) (verified database.ErasureReceiptORM, err error) {
+	return mw.next.VerifyErasureReceipt(ctx, receipt)
+}
+
+// An instrumenting wrapper around the ListUsers service implementation
+func (mw instrumentingMiddleware) ListUsers(ctx context.Context, query database.ListQuery, cursor string) (users []*user_service.UserORM, nextCursor string, err error) {
+	return mw.next.ListUsers(ctx, query, cursor)
+}
+
+// An instrumenting wrapper around the ListTeams service implementation
+func (mw instrumentingMiddleware) ListTeams(ctx context.Context, query database.ListQuery, cursor string) (teams []*user_service.TeamORM, nextCursor string, err error) {
+	return mw.next.ListTeams(ctx, query, cursor)
+}
+
+// An instrumenting wrapper around the ListGroups service implementation
+func (mw instrumentingMiddleware) ListGroups(ctx context.Context, query database.ListQuery, cursor string) (groups []*user_service.GroupORM, nextCursor string, err error) {
+	return mw.next.ListGroups(ctx, query, cursor)
+}
+
+// An instrumenting wrapper around the GetUsersByType service implementation
+func (mw instrumentingMiddleware) GetUsersByType(ctx context.Context, query string, maxResults int32, accountType user_service.AccountType) (users []*user_service.UserORM, err error) {
+	return mw.next.GetUsersByType(ctx, query, maxResults, accountType)
 }
 
 // An instrumenting wrapper around the GetUserById service implementation
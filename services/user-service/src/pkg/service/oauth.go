@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/url"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// authorizationCodeExpiry bounds the lifetime of authorization codes as recommended by RFC 6749
+const authorizationCodeExpiry = 5 * time.Minute
+
+// RegisterOAuthClient registers a third party application owned by the authenticated user. Confidential
+// clients are issued a secret which is only ever returned by this call
+func (s basicService) RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes []string,
+	public bool) (secret string, client database.OAuthClientORM, err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return "", client, err
+	}
+
+	if name == "" || len(redirectURIs) == 0 {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return "", client, helper.ErrInvalidArgumentProvided
+	}
+
+	for _, redirectURI := range redirectURIs {
+		uri, err := url.Parse(redirectURI)
+		if err != nil || !uri.IsAbs() || uri.Fragment != "" {
+			s.logger.Error(helper.ErrInvalidArgumentProvided.Error(), zap.String("redirect_uri", redirectURI))
+			return "", client, helper.ErrInvalidArgumentProvided
+		}
+	}
+
+	if len(scopes) == 0 {
+		scopes = []string{auth.ScopeRead}
+	}
+	for _, scope := range scopes {
+		if !auth.IsKnownScope(scope) {
+			s.logger.Error(helper.ErrInvalidScope.Error())
+			return "", client, helper.ErrInvalidScope
+		}
+	}
+
+	client = database.OAuthClientORM{
+		ClientId:     auth.NewTokenId(),
+		Name:         name,
+		RedirectURIs: redirectURIs,
+		Scopes:       scopes,
+		Public:       public,
+		OwnerId:      principal.UserId,
+	}
+
+	if !public {
+		secret, err = auth.NewOpaqueToken()
+		if err != nil {
+			s.logger.Error(err.Error())
+			return "", database.OAuthClientORM{}, err
+		}
+		client.ClientSecretHash = auth.HashToken(secret)
+	}
+
+	if err := s.database.CreateOAuthClient(&client); err != nil {
+		return "", database.OAuthClientORM{}, err
+	}
+
+	s.logger.Info("OAuth client registered", zap.String("client_id", client.ClientId), zap.String("name", name))
+	return secret, client, nil
+}
+
+// Authorize validates an authorization request on behalf of the authenticated user. If the user
+// already consented to the requested scopes, or grants consent as part of this request, an
+// authorization code is issued and the location the user agent should be redirected to is returned.
+// Errors detected once the redirect uri is known are also reported through the returned location
+func (s basicService) Authorize(ctx context.Context, req auth.AuthorizationRequest, grantConsent bool) (redirectURI string, err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := s.getOAuthClient(req.ClientId)
+	if err != nil {
+		return "", err
+	}
+
+	redirect, ok := resolveRedirectURI(client, req.RedirectURI)
+	if !ok {
+		// never redirect to an unregistered location
+		s.logger.Error(helper.ErrInvalidRequest.Error(), zap.String("redirect_uri", req.RedirectURI))
+		return "", helper.ErrInvalidRequest
+	}
+
+	if req.ResponseType != auth.CodeResponseType {
+		return authorizationErrorRedirect(redirect, req.State, helper.ErrUnsupportedResponseType), helper.ErrUnsupportedResponseType
+	}
+
+	if req.CodeChallenge == "" || req.CodeChallengeMethod != auth.CodeChallengeMethodS256 {
+		return authorizationErrorRedirect(redirect, req.State, helper.ErrInvalidRequest), helper.ErrInvalidRequest
+	}
+
+	scopes := req.Scopes
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	for _, scope := range scopes {
+		if !contains(client.Scopes, scope) || !principal.HasScope(scope) {
+			return authorizationErrorRedirect(redirect, req.State, helper.ErrInvalidScope), helper.ErrInvalidScope
+		}
+	}
+
+	err, consent := s.database.GetOAuthConsent(principal.UserId, client.ClientId)
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return "", err
+	}
+
+	if consent == nil || consent.RevokedAt != nil || !containsAll(consent.Scopes, scopes) {
+		if !grantConsent {
+			return "", helper.ErrConsentRequired
+		}
+
+		if consent == nil {
+			consent = &database.OAuthConsentORM{UserId: principal.UserId, ClientId: client.ClientId}
+		}
+		consent.RevokedAt = nil
+		for _, scope := range scopes {
+			if !contains(consent.Scopes, scope) {
+				consent.Scopes = append(consent.Scopes, scope)
+			}
+		}
+
+		if err := s.database.SaveOAuthConsent(*consent); err != nil {
+			return "", err
+		}
+	}
+
+	code, err := auth.NewOpaqueToken()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return "", err
+	}
+
+	expiresAt := time.Now().Add(authorizationCodeExpiry)
+	err = s.database.CreateAuthorizationCode(database.OAuthAuthorizationCodeORM{
+		CodeHash:            auth.HashToken(code),
+		ClientId:            client.ClientId,
+		UserId:              principal.UserId,
+		RedirectURI:         req.RedirectURI,
+		Scopes:              scopes,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		FamilyId:            auth.NewTokenId(),
+		ExpiresAt:           &expiresAt,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("code", code)
+	if req.State != "" {
+		params.Set("state", req.State)
+	}
+	return withQuery(redirect, params), nil
+}
+
+// ExchangeToken implements the oauth2 token endpoint. Authorization codes and refresh tokens
+// issued to the authenticated client are exchanged for a new token pair
+func (s basicService) ExchangeToken(ctx context.Context, req auth.TokenRequest) (token auth.TokenPair, err error) {
+	client, err := s.authenticateOAuthClient(req.ClientId, req.ClientSecret)
+	if err != nil {
+		return token, err
+	}
+
+	switch req.GrantType {
+	case auth.AuthorizationCodeGrantType:
+		return s.exchangeAuthorizationCode(ctx, client, req)
+	case auth.RefreshTokenGrantType:
+		token, err = s.rotateRefreshToken(ctx, req.RefreshToken, client.ClientId)
+		switch err {
+		case helper.ErrInvalidRefreshToken, helper.ErrRefreshTokenExpired, helper.ErrRefreshTokenReused:
+			return token, helper.ErrInvalidGrant
+		}
+		return token, err
+	default:
+		s.logger.Error(helper.ErrUnsupportedGrantType.Error(), zap.String("grant_type", req.GrantType))
+		return token, helper.ErrUnsupportedGrantType
+	}
+}
+
+// exchangeAuthorizationCode redeems an authorization code after verifying its PKCE code verifier.
+// Replaying a code revokes every token issued from it
+func (s basicService) exchangeAuthorizationCode(ctx context.Context, client *database.OAuthClientORM, req auth.TokenRequest) (token auth.TokenPair, err error) {
+	if req.Code == "" {
+		return token, helper.ErrInvalidRequest
+	}
+
+	err, code := s.database.GetAuthorizationCodeByHash(auth.HashToken(req.Code))
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return token, helper.ErrInvalidGrant
+		}
+		return token, err
+	}
+
+	if code.ClientId != client.ClientId {
+		return token, helper.ErrInvalidGrant
+	}
+
+	if code.UsedAt != nil {
+		s.logger.Error("authorization code replayed", zap.String("client_id", client.ClientId))
+		_ = s.database.RevokeRefreshTokenFamily(code.FamilyId)
+		return token, helper.ErrInvalidGrant
+	}
+
+	if code.ExpiresAt == nil || code.ExpiresAt.Before(time.Now()) || code.RedirectURI != req.RedirectURI {
+		return token, helper.ErrInvalidGrant
+	}
+
+	if !auth.VerifyCodeChallenge(code.CodeChallenge, req.CodeVerifier) {
+		s.logger.Error("pkce verification failed", zap.String("client_id", client.ClientId))
+		return token, helper.ErrInvalidGrant
+	}
+
+	if err := s.database.RedeemAuthorizationCode(code.Id); err != nil {
+		if err == helper.ErrInvalidGrant {
+			_ = s.database.RevokeRefreshTokenFamily(code.FamilyId)
+		}
+		return token, err
+	}
+
+	err, user := s.database.GetUserById(ctx, code.UserId)
+	if err != nil {
+		return token, err
+	}
+
+	return s.issueTokens(*user, tokenGrant{familyId: code.FamilyId, clientId: client.ClientId, scopes: code.Scopes,
+		client: auth.ClientFromContext(ctx)}, nil)
+}
+
+// authenticateOAuthClient obtains a registered oauth client and verifies its secret. Public clients
+// authenticate with their client id alone
+func (s basicService) authenticateOAuthClient(clientId string, clientSecret string) (*database.OAuthClientORM, error) {
+	client, err := s.getOAuthClient(clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.Public {
+		return client, nil
+	}
+
+	hash := auth.HashToken(clientSecret)
+	if clientSecret == "" || subtle.ConstantTimeCompare([]byte(hash), []byte(client.ClientSecretHash)) != 1 {
+		s.logger.Error(helper.ErrInvalidClient.Error(), zap.String("client_id", clientId))
+		return nil, helper.ErrInvalidClient
+	}
+
+	return client, nil
+}
+
+// getOAuthClient obtains an active oauth client by its client id
+func (s basicService) getOAuthClient(clientId string) (*database.OAuthClientORM, error) {
+	if clientId == "" {
+		return nil, helper.ErrInvalidClient
+	}
+
+	err, client := s.database.GetOAuthClientByClientId(clientId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, helper.ErrInvalidClient
+		}
+		return nil, err
+	}
+
+	if client.RevokedAt != nil {
+		return nil, helper.ErrInvalidClient
+	}
+
+	return client, nil
+}
+
+// resolveRedirectURI matches a requested redirect uri against the uris registered by a client. Clients
+// registering a single uri may omit it from their requests
+func resolveRedirectURI(client *database.OAuthClientORM, requested string) (string, bool) {
+	if requested == "" {
+		if len(client.RedirectURIs) == 1 {
+			return client.RedirectURIs[0], true
+		}
+		return "", false
+	}
+	return requested, contains(client.RedirectURIs, requested)
+}
+
+// authorizationErrorRedirect builds the location reporting an authorization error back to a client
+func authorizationErrorRedirect(redirectURI string, state string, err error) string {
+	code := err.Error()
+	if err == helper.ErrInvalidScope {
+		code = "invalid_scope"
+	}
+
+	params := url.Values{}
+	params.Set("error", code)
+	if state != "" {
+		params.Set("state", state)
+	}
+	return withQuery(redirectURI, params)
+}
+
+// withQuery appends a set of query parameters to a uri
+func withQuery(uri string, params url.Values) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+
+	query := parsed.Query()
+	for key, values := range params {
+		for _, value := range values {
+			query.Add(key, value)
+		}
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+// contains reports whether a value is part of a set of strings
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAll reports whether every one of the expected values is part of a set of strings
+func containsAll(values []string, expected []string) bool {
+	for _, value := range expected {
+		if !contains(values, value) {
+			return false
+		}
+	}
+	return true
+}
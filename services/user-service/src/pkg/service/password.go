@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// ForgotPassword issues a single use password reset token to the user owning a given email and
+// publishes a reset email to the lens_password_reset_email queue. Only the hash of the token is
+// stored. Unknown emails are not reported to the caller so that accounts cannot be enumerated
+func (s basicService) ForgotPassword(ctx context.Context, email string) (err error) {
+	if email == "" {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return helper.ErrInvalidArgumentProvided
+	}
+
+	err, user := s.database.GetUserByEmail(ctx, email)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			s.logger.Info("Password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	token, err := auth.NewOpaqueToken()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	expiresAt := time.Now().Add(config.Config.ResetTokenExpiry)
+	if err := s.database.SetUserResetToken(user.Id, auth.HashToken(token), expiresAt); err != nil {
+		return err
+	}
+
+	link := strings.TrimSuffix(config.Config.AppUrl, "/") + "/password/reset?token=" + url.QueryEscape(token)
+	message := helper.EmailMessage{
+		UserId:  user.Id,
+		Email:   user.Email,
+		Message: helper.PasswordResetMessage(user.FirstName, user.LastName, link),
+	}
+
+	err = s.ProducerQueues.SendMessageToQueue(message.String(), "lens_password_reset_email")
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	s.logger.Info("Password reset token issued", zap.Int32("user_id", user.Id))
+	return nil
+}
+
+// ResetPassword consumes a password reset token, replaces the password of its owner and revokes
+// every refresh token previously issued to them
+func (s basicService) ResetPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	if token == "" {
+		s.logger.Error(helper.ErrInvalidResetToken.Error())
+		return helper.ErrInvalidResetToken
+	}
+
+	if password == "" {
+		s.logger.Error(helper.ErrNoPasswordProvided.Error())
+		return helper.ErrNoPasswordProvided
+	}
+
+	tokenHash := auth.HashToken(token)
+	err, user := s.database.GetUserByResetToken(tokenHash)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrInvalidResetToken
+		}
+		return err
+	}
+
+	if user.ResetTokenExpiration == nil || user.ResetTokenExpiration.Before(time.Now()) {
+		s.logger.Error(helper.ErrResetTokenExpired.Error(), zap.Int32("user_id", user.Id))
+		return helper.ErrResetTokenExpired
+	}
+
+	user.Password = password
+	user.PasswordConfirmed = passwordConfirmed
+	hashedUser, err := s.validateAndHashPassword(ctx, *user)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.ResetUserPassword(user.Id, tokenHash, hashedUser.Password); err != nil {
+		return err
+	}
+
+	s.recordPasswordHistory(user.Id, hashedUser.Password)
+
+	// sessions established with the previous password are no longer trusted
+	if err := s.database.RevokeUserRefreshTokens(user.Id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Password reset", zap.Int32("user_id", user.Id))
+	return nil
+}
+
+// ChangePassword replaces the password of the authenticated user provided their current password is
+// presented. Every session of the user, including the current one, is revoked
+func (s basicService) ChangePassword(ctx context.Context, currentPassword, password, passwordConfirmed string) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	err, user := s.database.GetUserById(ctx, principal.UserId)
+	if err != nil {
+		return err
+	}
+
+	if !s.comparePasswords(user.Password, []byte(currentPassword)) {
+		s.logger.Error(helper.ErrInvalidPasswordProvided.Error(), zap.Int32("user_id", user.Id))
+		return helper.ErrInvalidPasswordProvided
+	}
+
+	currentHash := user.Password
+	user.Password = password
+	user.PasswordConfirmed = passwordConfirmed
+	hashedUser, err := s.validateAndHashPassword(ctx, *user)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.ChangeUserPassword(user.Id, currentHash, hashedUser.Password); err != nil {
+		return err
+	}
+
+	s.recordPasswordHistory(user.Id, hashedUser.Password)
+
+	// sessions established with the previous password are no longer trusted
+	if err := s.database.RevokeUserRefreshTokens(user.Id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Password changed", zap.Int32("user_id", user.Id))
+	return nil
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
+)
+
+// RoleStore resolves the roles users hold on teams and groups
+type RoleStore interface {
+	GetMembership(resourceType string, resourceId int32, userId int32) (error, *database.MembershipORM)
+}
+
+// AuthorizationMiddleware returns a middleware ensuring the authenticated principal holds a role
+// granting the permission required by update, delete and membership operations on teams and groups,
+// and that impersonated principals do not touch credentials. Every other operation is passed through
+// untouched
+func AuthorizationMiddleware(roles RoleStore, logger *zap.Logger) Middleware {
+	return func(next Service) Service {
+		return authorizationMiddleware{Service: next, roles: roles, logger: logger}
+	}
+}
+
+// Authorization Struct that implements the Service interface. Operations which do not require
+// a role are served by the embedded service
+type authorizationMiddleware struct {
+	Service
+	roles  RoleStore
+	logger *zap.Logger
+}
+
+// An authorizing wrapper around the UpdateTeam service implementation
+func (mw authorizationMiddleware) UpdateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	if err := mw.authorize(ctx, rbac.TeamResource, team.Id, rbac.PermissionUpdate); err != nil {
+		return err
+	}
+	return mw.Service.UpdateTeam(ctx, team)
+}
+
+// An authorizing wrapper around the ChangeTeamCredentials service implementation. Callers omitting the
+// current password of the team must be allowed to manage its credentials
+func (mw authorizationMiddleware) ChangeTeamCredentials(ctx context.Context, id int32, currentPassword, email, password, passwordConfirmed string) (err error) {
+	if err := mw.rejectImpersonation(ctx, "ChangeTeamCredentials"); err != nil {
+		return err
+	}
+
+	permission := rbac.PermissionUpdate
+	if currentPassword == "" {
+		permission = rbac.PermissionManageCredentials
+	}
+
+	if err := mw.authorize(ctx, rbac.TeamResource, id, permission); err != nil {
+		return err
+	}
+	return mw.Service.ChangeTeamCredentials(ctx, id, currentPassword, email, password, passwordConfirmed)
+}
+
+// An authorizing wrapper around the DeleteTeam service implementation
+func (mw authorizationMiddleware) DeleteTeam(ctx context.Context, id int32) (err error) {
+	if err := mw.authorize(ctx, rbac.TeamResource, id, rbac.PermissionDelete); err != nil {
+		return err
+	}
+	return mw.Service.DeleteTeam(ctx, id)
+}
+
+// An authorizing wrapper around the SetTeamMember service implementation
+func (mw authorizationMiddleware) SetTeamMember(ctx context.Context, teamId, userId int32, role string) (err error) {
+	if err := mw.authorizeMembership(ctx, rbac.TeamResource, teamId, userId, role); err != nil {
+		return err
+	}
+	return mw.Service.SetTeamMember(ctx, teamId, userId, role)
+}
+
+// An authorizing wrapper around the RemoveTeamMember service implementation
+func (mw authorizationMiddleware) RemoveTeamMember(ctx context.Context, teamId, userId int32) (err error) {
+	if err := mw.authorizeMembership(ctx, rbac.TeamResource, teamId, userId, ""); err != nil {
+		return err
+	}
+	return mw.Service.RemoveTeamMember(ctx, teamId, userId)
+}
+
+// An authorizing wrapper around the UpdateGroup service implementation
+func (mw authorizationMiddleware) UpdateGroup(ctx context.Context, group user_service.GroupORM) (err error) {
+	if err := mw.authorize(ctx, rbac.GroupResource, group.Id, rbac.PermissionUpdate); err != nil {
+		return err
+	}
+	return mw.Service.UpdateGroup(ctx, group)
+}
+
+// An authorizing wrapper around the DeleteGroup service implementation
+func (mw authorizationMiddleware) DeleteGroup(ctx context.Context, id int32) (err error) {
+	if err := mw.authorize(ctx, rbac.GroupResource, id, rbac.PermissionDelete); err != nil {
+		return err
+	}
+	return mw.Service.DeleteGroup(ctx, id)
+}
+
+// An authorizing wrapper around the SetGroupMember service implementation
+func (mw authorizationMiddleware) SetGroupMember(ctx context.Context, groupId, userId int32, role string) (err error) {
+	if err := mw.authorizeMembership(ctx, rbac.GroupResource, groupId, userId, role); err != nil {
+		return err
+	}
+	return mw.Service.SetGroupMember(ctx, groupId, userId, role)
+}
+
+// An authorizing wrapper around the RemoveGroupMember service implementation
+func (mw authorizationMiddleware) RemoveGroupMember(ctx context.Context, groupId, userId int32) (err error) {
+	if err := mw.authorizeMembership(ctx, rbac.GroupResource, groupId, userId, ""); err != nil {
+		return err
+	}
+	return mw.Service.RemoveGroupMember(ctx, groupId, userId)
+}
+
+// authorize ensures the authenticated principal holds a role granting a given permission on a team or
+// group. Platform staff are granted every permission
+func (mw authorizationMiddleware) authorize(ctx context.Context, resourceType string, resourceId int32, permission string) error {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return helper.ErrUnauthorized
+	}
+
+	if principal.HasScope(auth.ScopeAdmin) {
+		return nil
+	}
+
+	role, err := mw.principalRole(principal, resourceType, resourceId)
+	if err != nil {
+		return err
+	}
+
+	if !rbac.RoleHasPermission(role, permission) {
+		mw.logger.Error(helper.ErrForbidden.Error(), zap.String("resource_type", resourceType),
+			zap.Int32("resource_id", resourceId), zap.String("permission", permission))
+		return helper.ErrForbidden
+	}
+
+	return nil
+}
+
+// authorizeMembership ensures the authenticated principal may grant a role to, or with an empty role
+// revoke the role of, a given user. Granting or revoking the owner and admin roles requires managing
+// roles while users may always leave a team or group on their own
+func (mw authorizationMiddleware) authorizeMembership(ctx context.Context, resourceType string, resourceId int32,
+	userId int32, role string) error {
+	if role != "" && !rbac.IsKnownRole(role) {
+		return helper.ErrInvalidRole
+	}
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return helper.ErrUnauthorized
+	}
+
+	if role == "" && principal.IsFirstParty() && principal.UserId == userId {
+		return nil
+	}
+
+	currentRole, err := mw.userRole(resourceType, resourceId, userId)
+	if err != nil {
+		return err
+	}
+
+	permission := rbac.PermissionManageMembers
+	if rbac.IsPrivilegedRole(role) || rbac.IsPrivilegedRole(currentRole) {
+		permission = rbac.PermissionManageRoles
+	}
+
+	return mw.authorize(ctx, resourceType, resourceId, permission)
+}
+
+// principalRole resolves the role a principal holds on a team or group. Teams authenticated with their
+// own credentials administer themselves, api keys and oauth clients hold no role
+func (mw authorizationMiddleware) principalRole(principal auth.Principal, resourceType string, resourceId int32) (string, error) {
+	if principal.IsTeam() {
+		if resourceType == rbac.TeamResource && principal.TeamId == resourceId {
+			return rbac.RoleAdmin, nil
+		}
+		return "", nil
+	}
+
+	if !principal.IsFirstParty() {
+		return "", nil
+	}
+
+	return mw.userRole(resourceType, resourceId, principal.UserId)
+}
+
+// userRole resolves the role a user holds on a team or group, if any
+func (mw authorizationMiddleware) userRole(resourceType string, resourceId int32, userId int32) (string, error) {
+	err, membership := mw.roles.GetMembership(resourceType, resourceId, userId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return membership.Role, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// GetUsersByType searches the active users of a given account type, such as investors or startups,
+// whose name, username or intent match a query. An empty query matches every user of the type. Users are
+// projected onto their public fields
+func (s basicService) GetUsersByType(ctx context.Context, query string, maxResults int32, accountType user_service.AccountType) (users []*user_service.UserORM, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, helper.ErrUnauthorized
+	}
+
+	if _, ok := user_service.AccountType_name[int32(accountType)]; !ok {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return nil, helper.ErrInvalidArgumentProvided
+	}
+
+	err, users = s.database.SearchUsers(ctx, query, accountType.String(), helper.PageSize(int(maxResults)))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, user := range users {
+		projected := publicUser(principal, *user)
+		users[i] = &projected
+	}
+
+	return users, nil
+}
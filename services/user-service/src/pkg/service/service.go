@@ -2,16 +2,19 @@ package service
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"strconv"
+	"strings"
+	"time"
 	"unsafe"
 
 	"github.com/go-kit/kit/metrics"
 	"github.com/jinzhu/gorm"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/protobuf/field_mask"
 	"gopkg.in/go-playground/validator.v9"
 
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
 	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
 	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
 	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
@@ -24,6 +27,49 @@ type Service interface {
 	// if it doesm't already exist.
 	CreateUser(ctx context.Context, user user_service.UserORM) (err error)
 
+	// UpdateUser updates the fields of a user listed by a field mask on behalf of the user or of platform
+	// staff and returns the updated user. Credentials, the email and the identity of the user are
+	// protected and may not be part of the mask.
+	UpdateUser(ctx context.Context, user user_service.UserORM, updateMask *field_mask.FieldMask) (updated user_service.UserORM, err error)
+
+	// DeleteUser soft deletes a user on behalf of the user or of platform staff. The user may be restored
+	// until the deletion grace period elapses, after which the user is purged.
+	DeleteUser(ctx context.Context, id int32) (err error)
+
+	// RestoreUser restores a deleted user whose deletion grace period has not elapsed. Reserved to
+	// platform staff.
+	RestoreUser(ctx context.Context, id int32) (err error)
+
+	// RequestDataExport schedules an export of every record held about a user on behalf of the user or
+	// of platform staff. A message is published once the export may be downloaded.
+	RequestDataExport(ctx context.Context, id int32) (export database.DataExportORM, err error)
+
+	// DownloadDataExport returns the zip archive of a completed data export.
+	DownloadDataExport(ctx context.Context, id int32, exportId int32) (archive []byte, err error)
+
+	// EraseUser anonymizes every record held about a user on behalf of the user or of platform staff,
+	// hands over the resources they solely administer and returns a signed receipt of the erasure.
+	EraseUser(ctx context.Context, id int32) (receipt database.ErasureReceiptORM, err error)
+
+	// VerifyErasureReceipt checks that an erasure receipt was issued by the service.
+	VerifyErasureReceipt(ctx context.Context, receipt string) (verified database.ErasureReceiptORM, err error)
+
+	// ListUsers returns a page of the users of the account of the caller matching a set of filters,
+	// sorted by the requested key, alongside an opaque cursor pointing to the next page. Contact details
+	// are only included for the caller themselves and for platform staff.
+	ListUsers(ctx context.Context, query database.ListQuery, cursor string) (users []*user_service.UserORM, nextCursor string, err error)
+
+	// ListTeams returns a page of the teams matching a set of filters alongside the cursor of the next page.
+	ListTeams(ctx context.Context, query database.ListQuery, cursor string) (teams []*user_service.TeamORM, nextCursor string, err error)
+
+	// ListGroups returns a page of the groups matching a set of filters alongside the cursor of the next page.
+	ListGroups(ctx context.Context, query database.ListQuery, cursor string) (groups []*user_service.GroupORM, nextCursor string, err error)
+
+	// GetUsersByType searches the active users of an account type whose name, username or intent match
+	// a query, returning at most the requested amount of results. Contact details are only included for
+	// the caller themselves and for platform staff.
+	GetUsersByType(ctx context.Context, query string, maxResults int32, accountType user_service.AccountType) (users []*user_service.UserORM, err error)
+
 	// GetUserById queries the backend datastore for user objects based on a
 	// passed in user id parameter.
 	GetUserById(ctx context.Context, id string) (user user_service.UserORM, err error)
@@ -37,8 +83,153 @@ type Service interface {
 	GetUserByUsername(ctx context.Context, username string) (user user_service.UserORM, err error)
 
 	// LogIn Checks if a user object exists in the backend datastore, performs some password checks,
-	// and attempts to log a given user into the system
-	LogIn(ctx context.Context, username, password string) (user user_service.UserORM, err error)
+	// and attempts to log a given user into the system. On success an access and refresh token pair
+	// is issued for the user. Users with two factor authentication enabled are instead issued a
+	// challenge which must be completed through VerifyTwoFactor.
+	LogIn(ctx context.Context, username, password string) (user user_service.UserORM, token auth.TokenPair, challenge string, err error)
+
+	// RefreshToken exchanges a valid refresh token for a new token pair. The presented refresh
+	// token is rotated and may not be used again.
+	RefreshToken(ctx context.Context, refreshToken string) (token auth.TokenPair, err error)
+
+	// LogOut revokes the presented refresh token as well as every token rotated from the same log in
+	LogOut(ctx context.Context, refreshToken string) (err error)
+
+	// CreateAPIKey issues an api key belonging to the authenticated user or to one of their teams.
+	// The plain text key is only ever returned by this call.
+	CreateAPIKey(ctx context.Context, name string, teamId int32, scopes []string, expiresIn time.Duration) (key string, apiKey database.APIKeyORM, err error)
+
+	// ListAPIKeys lists the api keys belonging to the authenticated user and optionally to one of their teams
+	ListAPIKeys(ctx context.Context, teamId int32) (keys []*database.APIKeyORM, err error)
+
+	// RevokeAPIKey revokes an api key belonging to the authenticated user or to one of their teams
+	RevokeAPIKey(ctx context.Context, id int32) (err error)
+
+	// RegisterOAuthClient registers a third party application against the oauth2 authorization server.
+	// Confidential clients are issued a secret which is only ever returned by this call.
+	RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes []string, public bool) (secret string, client database.OAuthClientORM, err error)
+
+	// Authorize issues an authorization code to an oauth client on behalf of the authenticated user and
+	// returns the location the user agent should be redirected to.
+	Authorize(ctx context.Context, req auth.AuthorizationRequest, grantConsent bool) (redirectURI string, err error)
+
+	// ExchangeToken exchanges an authorization code or a refresh token issued to an oauth client for a token pair.
+	ExchangeToken(ctx context.Context, req auth.TokenRequest) (token auth.TokenPair, err error)
+
+	// GetOpenIDConfiguration returns the OpenID Connect discovery document of the authorization server.
+	GetOpenIDConfiguration(ctx context.Context) (configuration auth.OpenIDConfiguration, err error)
+
+	// GetJSONWebKeySet returns the public keys issued tokens may be verified with.
+	GetJSONWebKeySet(ctx context.Context) (keys auth.JSONWebKeySet, err error)
+
+	// ForgotPassword issues a single use password reset token to the user owning a given email and
+	// emails it to them.
+	ForgotPassword(ctx context.Context, email string) (err error)
+
+	// ResetPassword consumes a password reset token and replaces the password of its owner. Every
+	// session of the user is invalidated.
+	ResetPassword(ctx context.Context, token, password, passwordConfirmed string) (err error)
+
+	// ChangeEmail records a new email address as pending for the authenticated user and sends a
+	// confirmation link to it. The email of the user changes once the new address is confirmed.
+	ChangeEmail(ctx context.Context, email string) (err error)
+
+	// ConfirmEmailChange consumes an email change token and swaps the email of its owner.
+	ConfirmEmailChange(ctx context.Context, token string) (err error)
+
+	// VerifyEmail activates the account a signed verification token was issued for.
+	VerifyEmail(ctx context.Context, token string) (err error)
+
+	// ResendVerification sends a new verification link to the owner of an inactive account.
+	ResendVerification(ctx context.Context, email string) (err error)
+
+	// EnrollTwoFactor generates a pending two factor secret for the authenticated user and returns it
+	// alongside the otpauth uri authenticator apps are provisioned with.
+	EnrollTwoFactor(ctx context.Context) (secret string, uri string, err error)
+
+	// ConfirmTwoFactor enables two factor authentication once a code generated from the pending secret
+	// is presented. The returned recovery codes are only ever returned by this call.
+	ConfirmTwoFactor(ctx context.Context, code string) (recoveryCodes []string, err error)
+
+	// DisableTwoFactor disables two factor authentication for the authenticated user.
+	DisableTwoFactor(ctx context.Context, code string) (err error)
+
+	// VerifyTwoFactor exchanges a log in challenge and a one time password or recovery code for a
+	// token pair.
+	VerifyTwoFactor(ctx context.Context, challenge, code string) (user user_service.UserORM, token auth.TokenPair, err error)
+
+	// UnlockUser clears the failed log in attempts and any lock of the account of a given user. Reserved
+	// to platform staff.
+	UnlockUser(ctx context.Context, id int32) (err error)
+
+	// Impersonate issues a time boxed access token letting a staff member act on behalf of a given user.
+	// Every operation performed with the token is audited. Reserved to platform staff.
+	Impersonate(ctx context.Context, userId int32) (token auth.TokenPair, err error)
+
+	// GetLoginActivity returns a page of the log in attempts made for a given user, most recent first,
+	// alongside the token of the next page if any. Only the user and platform staff may review them.
+	GetLoginActivity(ctx context.Context, id int32, pageSize int, pageToken string) (activities []*user_service.LoginActivityORM, nextPageToken string, err error)
+
+	// ChangePassword replaces the password of the authenticated user once their current password is
+	// verified. Every session of the user is revoked.
+	ChangePassword(ctx context.Context, currentPassword, password, passwordConfirmed string) (err error)
+
+	// ListSessions lists the active sessions of the authenticated user.
+	ListSessions(ctx context.Context) (sessions []*database.SessionORM, err error)
+
+	// RevokeSession ends a session of the authenticated user.
+	RevokeSession(ctx context.Context, id int32) (err error)
+
+	// RevokeAllSessions signs the authenticated user out of every session.
+	RevokeAllSessions(ctx context.Context) (err error)
+
+	// CreateTeam creates a team administered by the authenticated user. The password of the team, if
+	// any, is hashed prior to being persisted.
+	CreateTeam(ctx context.Context, team user_service.TeamORM) (err error)
+
+	// UpdateTeam updates the descriptive fields of a team on behalf of one of its owners or admins or of
+	// the team itself.
+	UpdateTeam(ctx context.Context, team user_service.TeamORM) (err error)
+
+	// ChangeTeamCredentials replaces the email and password of a team on behalf of one of its owners, or
+	// of one of its admins or the team itself provided the current password. Every session of the team
+	// is revoked.
+	ChangeTeamCredentials(ctx context.Context, id int32, currentPassword, email, password, passwordConfirmed string) (err error)
+
+	// DeleteTeam deletes a team on behalf of one of its owners.
+	DeleteTeam(ctx context.Context, id int32) (err error)
+
+	// SetTeamMember grants a role on a team to a given user, replacing any role they held on it.
+	SetTeamMember(ctx context.Context, teamId, userId int32, role string) (err error)
+
+	// RemoveTeamMember revokes the role a given user holds on a team.
+	RemoveTeamMember(ctx context.Context, teamId, userId int32) (err error)
+
+	// TeamLogIn authenticates a team with its own credentials and issues a token pair scoped to the team.
+	TeamLogIn(ctx context.Context, name, password string) (team user_service.TeamORM, token auth.TokenPair, err error)
+
+	// ForgotTeamPassword issues a single use password reset token to the team owning a given email and
+	// emails it to the team.
+	ForgotTeamPassword(ctx context.Context, email string) (err error)
+
+	// ResetTeamPassword consumes a team password reset token and replaces the password of the team.
+	// Every session of the team is invalidated.
+	ResetTeamPassword(ctx context.Context, token, password, passwordConfirmed string) (err error)
+
+	// CreateGroup creates a group owned by the authenticated user.
+	CreateGroup(ctx context.Context, group user_service.GroupORM) (err error)
+
+	// UpdateGroup updates a group on behalf of one of its owners or admins.
+	UpdateGroup(ctx context.Context, group user_service.GroupORM) (err error)
+
+	// DeleteGroup deletes a group on behalf of one of its owners.
+	DeleteGroup(ctx context.Context, id int32) (err error)
+
+	// SetGroupMember grants a role on a group to a given user, replacing any role they held on it.
+	SetGroupMember(ctx context.Context, groupId, userId int32, role string) (err error)
+
+	// RemoveGroupMember revokes the role a given user holds on a group.
+	RemoveGroupMember(ctx context.Context, groupId, userId int32) (err error)
 }
 
 // Counters is a type encompassing metrics for API definitions
@@ -52,16 +243,20 @@ type Counters struct {
 	FailedGetUserRequest        metrics.Counter
 	SuccessfulLogInRequest      metrics.Counter
 	FailedLogInRequest          metrics.Counter
+	AccountLockouts             metrics.Counter
 	Duration                    metrics.Histogram
 }
 
 var validate = validator.New()
 
 // New returns a basic Service with all of the expected middlewares wired in.
-func New(logger *zap.Logger, db *gorm.DB, amqpProducer queues.Queue, amqpConsumer queues.Queue, counters Counters) Service {
+func New(logger *zap.Logger, db *gorm.DB, tokens *auth.TokenManager, secrets *auth.Cipher, passwords auth.PasswordHasher,
+	policy *auth.PasswordPolicy, amqpProducer queues.Queue, amqpConsumer queues.Queue, counters Counters) Service {
 	var svc Service
 	{
-		svc = NewBasicService(db, logger, amqpProducer, amqpConsumer)
+		svc = NewBasicService(db, logger, tokens, secrets, passwords, policy, amqpProducer, amqpConsumer, counters.AccountLockouts)
+		svc = AuthorizationMiddleware(&database.Database{Engine: db, Logger: logger}, logger)(svc)
+		svc = AuditMiddleware(&database.Database{Engine: db, Logger: logger}, logger)(svc)
 		svc = LoggingMiddleware(logger)(svc)
 		svc = InstrumentingMiddleware(counters)(svc)
 	}
@@ -69,72 +264,128 @@ func New(logger *zap.Logger, db *gorm.DB, amqpProducer queues.Queue, amqpConsume
 }
 
 // NewBasicService returns a naïve, stateless implementation of Service.
-func NewBasicService(db *gorm.DB, logger *zap.Logger, amqpProducer queues.Queue, amqpConsumer queues.Queue) Service {
-	return basicService{logger: logger, database: &database.Database{db, logger},
-		ConsumerQueues: amqpConsumer, ProducerQueues: amqpProducer}
+func NewBasicService(db *gorm.DB, logger *zap.Logger, tokens *auth.TokenManager, secrets *auth.Cipher, passwords auth.PasswordHasher,
+	policy *auth.PasswordPolicy, amqpProducer queues.Queue, amqpConsumer queues.Queue, lockouts metrics.Counter) Service {
+	return basicService{logger: logger, database: &database.Database{Engine: db, Logger: logger}, tokens: tokens,
+		secrets: secrets, passwords: passwords, policy: policy, lockouts: lockouts, ConsumerQueues: amqpConsumer, ProducerQueues: amqpProducer}
 }
 
 // basicService is a type witholding references to logging, the backend datastroe,
-// as well as queues (producer and consumer)
+// token issuance, encryption of persisted secrets, password hashing and policy, lockout metrics, as well
+// as queues (producer and consumer)
 type basicService struct {
 	logger         *zap.Logger
 	database       *database.Database
+	tokens         *auth.TokenManager
+	secrets        *auth.Cipher
+	passwords      auth.PasswordHasher
+	policy         *auth.PasswordPolicy
+	lockouts       metrics.Counter
 	ConsumerQueues queues.Queue
 	ProducerQueues queues.Queue
 }
 
 // Login performs some validation and attempts to log in a user into the system if the input parameters
-// are indeed correct
-func (s basicService) LogIn(ctx context.Context, username, password string) (user user_service.UserORM, err error) {
+// are indeed correct. A signed access and refresh token pair is returned for authenticated users unless
+// two factor authentication is enabled, in which case a challenge token is returned instead
+func (s basicService) LogIn(ctx context.Context, username, password string) (user user_service.UserORM, token auth.TokenPair, challenge string, err error) {
 	if username == "" {
 		s.logger.Error(helper.ErrNoUsernameProvided.Error())
-		return user, helper.ErrNoUsernameProvided
+		return user, token, challenge, helper.ErrNoUsernameProvided
 	}
 
 	if password == "" {
 		s.logger.Error(helper.ErrNoPasswordProvided.Error())
-		return user, helper.ErrNoPasswordProvided
+		return user, token, challenge, helper.ErrNoPasswordProvided
+	}
+
+	// guessing is slowed down per username and per address, every attempt is recorded
+	throttles := loginThrottles(username, auth.ClientFromContext(ctx))
+	if err := s.checkLoginThrottles(throttles); err != nil {
+		s.recordLoginActivity(ctx, 0, username, loginOutcome(err))
+		return user, token, challenge, err
 	}
 
 	// check if user exists in the database
-	err, currentUser := s.database.GetUserByUsername(username)
+	err, currentUser := s.database.GetUserByUsername(ctx, username)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if gorm.IsRecordNotFoundError(err) {
 			s.logger.Error(helper.ErrInvalidUsernameProvided.Error())
-			return user, helper.ErrInvalidUsernameProvided
+			s.recordLoginFailure(throttles)
+			s.recordLoginActivity(ctx, 0, username, loginInvalidCredentials)
+			return user, token, challenge, helper.ErrInvalidUsernameProvided
 		}
 
-		return user, err
+		return user, token, challenge, err
 	}
 
-	// TODO: Might not want to log user passwords
-	s.logger.Info("Password", zap.String("password", currentUser.Password))
-
 	// check if passwords match
 	isEqual := s.comparePasswords(currentUser.Password, []byte(password))
 
 	if !isEqual {
 		s.logger.Error(helper.ErrInvalidPasswordProvided.Error())
-		return user_service.UserORM{}, helper.ErrInvalidPasswordProvided
+		s.recordLoginFailure(throttles)
+		s.recordLoginActivity(ctx, currentUser.Id, username, loginInvalidCredentials)
+		return user_service.UserORM{}, token, challenge, helper.ErrInvalidPasswordProvided
+	}
+
+	// hashes produced under a weaker configuration are upgraded while the plain password is at hand
+	s.rehashPassword(*currentUser, password)
+
+	if !currentUser.IsActive {
+		s.logger.Error(helper.ErrAccountNotVerified.Error(), zap.Int32("user_id", currentUser.Id))
+		s.recordLoginActivity(ctx, currentUser.Id, username, loginNotVerified)
+		return user_service.UserORM{}, token, challenge, helper.ErrAccountNotVerified
+	}
+
+	// users with two factor authentication enabled must complete a challenge prior to being issued tokens.
+	// Failures are only cleared once the challenge is completed
+	enabled, err := s.twoFactorEnabled(currentUser.Id)
+	if err != nil {
+		return user_service.UserORM{}, token, challenge, err
 	}
 
-	return user, nil
+	if enabled {
+		challenge, err = s.tokens.IssueChallengeToken(*currentUser)
+		if err != nil {
+			s.logger.Error(err.Error())
+			return user_service.UserORM{}, token, "", err
+		}
+		s.recordLoginActivity(ctx, currentUser.Id, username, loginChallenged)
+		return user_service.UserORM{}, token, challenge, nil
+	}
+
+	// every log in starts a new refresh token family
+	token, err = s.issueTokens(*currentUser, tokenGrant{familyId: auth.NewTokenId(), client: auth.ClientFromContext(ctx)}, nil)
+	if err != nil {
+		return user_service.UserORM{}, auth.TokenPair{}, "", err
+	}
+
+	s.resetLoginThrottle(username)
+	s.recordLoginActivity(ctx, currentUser.Id, username, loginSucceeded)
+	return sanitizeUser(*currentUser), token, "", nil
 }
 
 func (s basicService) GetUserById(ctx context.Context, id string) (user user_service.UserORM, err error) {
-	query := "SELECT * FROM users_table WHERE id =$1"
-	return s.getUserFromQueryParam(ctx, query, id)
+	userId, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return user, helper.ErrInvalidArgumentProvided
+	}
+
+	err, found := s.database.GetUserById(ctx, int32(userId))
+	return s.foundUser(found, err)
 }
 
 func (s basicService) GetUserByEmail(ctx context.Context, email string) (user user_service.UserORM, err error) {
-	query := "SELECT * FROM users_table WHERE email =$1"
-	return s.getUserFromQueryParam(ctx, query, email)
+	err, found := s.database.GetUserByEmail(ctx, email)
+	return s.foundUser(found, err)
 }
 
 func (s basicService) GetUserByUsername(ctx context.Context, username string) (user user_service.UserORM, err error) {
-	query := "SELECT * FROM users_table WHERE username =$1"
-	return s.getUserFromQueryParam(ctx, query, username)
+	err, found := s.database.GetUserByUsername(ctx, username)
+	return s.foundUser(found, err)
 }
 
 func (s basicService) CreateUser(ctx context.Context, currentuser user_service.UserORM) (err error) {
@@ -143,35 +394,104 @@ func (s basicService) CreateUser(ctx context.Context, currentuser user_service.U
 		return helper.ErrNoUserProvided
 	}
 
-	currentuser, err = s.validateAndHashPassword(currentuser)
+	// staff accounts are provisioned directly in the backend datastore
+	if currentuser.UserAccountType == auth.AdminAccountType {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return helper.ErrInvalidArgumentProvided
+	}
+
+	currentuser, err = s.validateAndHashPassword(ctx, currentuser)
 	if err != nil {
 		return err
 	}
 
-	err = s.database.CreateUser(currentuser)
+	// accounts remain inactive until their email is verified
+	currentuser.IsActive = false
+
+	err = s.database.CreateUser(ctx, currentuser)
 	if err != nil {
 		return err
 	}
 
 	s.logger.Info("User added", zap.String("Username", currentuser.UserName))
 
-	// TODO: Pass a struct array with a lot of data such as user id, msg id, email, content, time sent, etc
-	// write to the create welcome email queue
-	err = s.ProducerQueues.SendMessageToQueue(helper.WelcomeMessage(currentuser.FirstName, currentuser.LastName), "lens_welcome_email")
+	err, createdUser := s.database.GetUserByEmail(ctx, currentuser.Email)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	s.recordPasswordHistory(createdUser.Id, createdUser.Password)
+
+	// write to the create welcome email queue
+	return s.sendVerificationEmail(*createdUser)
 }
 
-// validateAndHashPassword checks if a given user password and confirmed password match
-func (s basicService) validateAndHashPassword(currentuser user_service.UserORM) (user user_service.UserORM, err error) {
+// UpdateUser updates the fields of a user listed by a field mask. Field mask paths name the fields of
+// the user, passwords, the email, tokens and the identity of the user are changed through their own
+// flows and can not be part of the mask
+func (s basicService) UpdateUser(ctx context.Context, user user_service.UserORM, updateMask *field_mask.FieldMask) (updated user_service.UserORM, err error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || principal.UserId == 0 {
+		return updated, helper.ErrUnauthorized
+	}
+
+	if principal.UserId != user.Id && !principal.HasScope(auth.ScopeAdmin) {
+		s.logger.Error(helper.ErrForbidden.Error())
+		return updated, helper.ErrForbidden
+	}
+
+	if len(updateMask.GetPaths()) == 0 {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return updated, helper.ErrInvalidArgumentProvided
+	}
+
+	for _, path := range updateMask.GetPaths() {
+		if isProtectedUserField(path) {
+			s.logger.Error(helper.ErrProtectedField.Error(), zap.String("path", path))
+			return updated, helper.ErrProtectedField
+		}
+	}
+
+	err, updatedUser := s.database.PatchUser(ctx, user, updateMask)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return updated, helper.ErrNotFound
+		}
+		return updated, err
+	}
+
+	s.logger.Info("User updated", zap.Int32("user_id", updatedUser.Id), zap.Strings("paths", updateMask.GetPaths()))
+	return sanitizeUser(*updatedUser), nil
+}
+
+// validateAndHashPassword checks if a given user password and confirmed password match and that the
+// password satisfies the password policy
+func (s basicService) validateAndHashPassword(ctx context.Context, currentuser user_service.UserORM) (user user_service.UserORM, err error) {
 	// check if confirmed password and actual password match
 	if currentuser.Password != currentuser.PasswordConfirmed {
 		s.logger.Error(helper.ErrPasswordsNotEqual.Error())
 		return currentuser, helper.ErrPasswordsNotEqual
 	}
+
+	// only existing users have previous passwords which may not be reused
+	var previousHashes []string
+	if currentuser.Id != 0 {
+		previousHashes, err = s.previousPasswordHashes(ctx, currentuser.Id)
+		if err != nil {
+			return currentuser, err
+		}
+	}
+
+	err = s.policy.Check(auth.PasswordCandidate{
+		Password:       currentuser.Password,
+		Username:       currentuser.UserName,
+		Email:          currentuser.Email,
+		PreviousHashes: previousHashes,
+	}, s.passwords)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return currentuser, err
+	}
 	//  hash password
 	hashedPassword, err := s.hashAndSalt([]byte(currentuser.Password))
 	if err != nil {
@@ -208,44 +528,144 @@ func (s basicService) validateUser(err error, currentuser user_service.UserORM)
 	return nil
 }
 
-// hashAndSalt hashes and salts a password
-func (s basicService) hashAndSalt(pwd []byte) (string, error) {
+// previousPasswordHashes obtains the hashes of the current password of a given user as well as of the
+// passwords they set most recently
+func (s basicService) previousPasswordHashes(ctx context.Context, userId int32) ([]string, error) {
+	if s.policy.History() <= 0 {
+		return nil, nil
+	}
 
-	// Use GenerateFromPassword to hash & salt pwd
-	// MinCost is just an integer constant provided by the bcrypt
-	// package along with DefaultCost & MaxCost.
-	// The cost can be any value you want provided it isn't lower
-	// than the MinCost (4)
-	hash, err := bcrypt.GenerateFromPassword(pwd, bcrypt.MinCost)
+	err, user := s.database.GetUserById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	err, history := s.database.GetPasswordHistory(userId, s.policy.History())
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := []string{user.Password}
+	for _, previous := range history {
+		hashes = append(hashes, previous.HashedPassword)
+	}
+	return hashes, nil
+}
+
+// recordPasswordHistory remembers a password set by a given user so that it can not be reused.
+// Failing to record it does not fail the operation which set the password
+func (s basicService) recordPasswordHistory(userId int32, hashedPassword string) {
+	if s.policy.History() <= 0 {
+		return
+	}
+
+	_ = s.database.AddPasswordHistory(userId, hashedPassword, s.policy.History())
+}
+
+// hashAndSalt hashes and salts a password with the configured algorithm
+func (s basicService) hashAndSalt(pwd []byte) (string, error) {
+	hash, err := s.passwords.Hash(string(pwd))
 	if err != nil {
 		s.logger.Error(err.Error())
 		return "", err
 	}
-	// GenerateFromPassword returns a byte slice so we need to
-	// convert the bytes to a string and return it
-	return string(hash), nil
+	return hash, nil
 }
 
 // comparePasswords compares a hashed password and a plain password
 func (s basicService) comparePasswords(hashedPwd string, plainPwd []byte) bool {
-	// Since we'll be getting the hashed password from the DB it
-	// will be a string so we'll need to convert it to a byte slice
-	byteHash := []byte(hashedPwd)
-	err := bcrypt.CompareHashAndPassword(byteHash, plainPwd)
+	isEqual, err := s.passwords.Verify(hashedPwd, string(plainPwd))
 	if err != nil {
 		s.logger.Error(err.Error())
 		return false
 	}
-	return true
+	return isEqual
 }
 
-// getUserFromQueryParam obtains a user based on a query parameter
-func (s basicService) getUserFromQueryParam(ctx context.Context, query string, param string) (user user_service.UserORM, err error) {
-	user, err = s.database.GetUserBasedOnParam(param, query)
+// rehashPassword replaces the password hash of a user once their password was verified if the hash
+// was produced with another algorithm or weaker parameters than the configured ones. Failing to
+// upgrade a hash does not fail the log in
+func (s basicService) rehashPassword(user user_service.UserORM, password string) {
+	if !s.passwords.NeedsRehash(user.Password) {
+		return
+	}
+
+	hash, err := s.hashAndSalt([]byte(password))
 	if err != nil {
-		s.logger.Error(err.Error())
+		return
+	}
+
+	if err := s.database.UpdatePasswordHash(user.Id, user.Password, hash); err != nil {
+		return
+	}
+
+	s.logger.Info("Password rehashed", zap.Int32("user_id", user.Id))
+}
+
+// protectedUserFields lists the fields of a user which can not be updated through a field mask. The
+// profile and subscriptions of a user are owned by their own endpoints while team and group references
+// are granted through memberships
+var protectedUserFields = map[string]bool{
+	"Id":                   true,
+	"AccountID":            true,
+	"CreatedAt":            true,
+	"UpdatedAt":            true,
+	"DeletedAt":            true,
+	"Email":                true,
+	"Password":             true,
+	"PasswordConfirmed":    true,
+	"ResetToken":           true,
+	"ResetTokenExpiration": true,
+	"UserAccountType":      true,
+	"IsActive":             true,
+	"ProfileId":            true,
+	"SubscriptionsId":      true,
+	"AdminGroupId":         true,
+	"AdminIdTeamId":        true,
+	"AdvisorsTeamId":       true,
+	"MembersTeamId":        true,
+	"GroupMembersGroupId":  true,
+}
+
+// isProtectedUserField reports whether a field mask path targets a protected field of a user or any
+// field nested within one
+func isProtectedUserField(path string) bool {
+	return protectedUserFields[strings.SplitN(path, ".", 2)[0]]
+}
+
+// sanitizeUser strips credentials from a user object prior to returning it to callers
+func sanitizeUser(user user_service.UserORM) user_service.UserORM {
+	user.Password = ""
+	user.PasswordConfirmed = ""
+	user.ResetToken = ""
+	user.ResetTokenExpiration = nil
+	return user
+}
+
+// publicUser projects a user onto the fields any member of the account may see. Contact and personal
+// details are only disclosed to the user themselves and to platform staff
+func publicUser(principal auth.Principal, user user_service.UserORM) user_service.UserORM {
+	user = sanitizeUser(user)
+	if principal.HasScope(auth.ScopeAdmin) || (principal.IsFirstParty() && principal.UserId == user.Id) {
+		return user
+	}
+
+	user.Email = ""
+	user.PhoneNumber = ""
+	user.BirthDate = ""
+	user.Gender = ""
+	user.Age = 0
+	return user
+}
+
+// foundUser returns a user obtained from the datastore stripped of its credentials
+func (s basicService) foundUser(user *user_service.UserORM, err error) (user_service.UserORM, error) {
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return user_service.UserORM{}, helper.ErrNotFound
+		}
 		return user_service.UserORM{}, err
 	}
 
-	return user, nil
+	return sanitizeUser(*user), nil
 }
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// ListSessions lists the active sessions of the authenticated user, flagging the session the
+// request was made from
+func (s basicService) ListSessions(ctx context.Context) (sessions []*database.SessionORM, err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err, sessions = s.database.GetActiveSessions(principal.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		session.Current = session.FamilyId == principal.SessionId
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession ends a session of the authenticated user. Refresh tokens as well as access tokens
+// issued for the session are no longer accepted
+func (s basicService) RevokeSession(ctx context.Context, id int32) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.RevokeSession(principal.UserId, id); err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrNotFound
+		}
+		return err
+	}
+
+	s.logger.Info("Session revoked", zap.Int32("user_id", principal.UserId), zap.Int32("session_id", id))
+	return nil
+}
+
+// RevokeAllSessions signs the authenticated user out everywhere, including from the session the
+// request was made from
+func (s basicService) RevokeAllSessions(ctx context.Context) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.RevokeUserRefreshTokens(principal.UserId); err != nil {
+		return err
+	}
+
+	s.logger.Info("All sessions revoked", zap.Int32("user_id", principal.UserId))
+	return nil
+}
@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/rbac"
+)
+
+// teamThrottlePrefix distinguishes the failure counters of team log ins from the ones of users
+const teamThrottlePrefix = "team:"
+
+// CreateTeam creates a team administered by the authenticated user. The team password, if any, is
+// checked against the password policy and hashed prior to being persisted
+func (s basicService) CreateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	// reset tokens are only ever issued by the service
+	team.ResetToken = ""
+	team.ResetTokenExpiration = nil
+	team.IsActive = true
+
+	if team.Password != "" {
+		team.Password, err = s.validateAndHashTeamPassword(team, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.database.CreateTeam(team); err != nil {
+		return err
+	}
+
+	err, createdTeam := s.database.GetTeamByName(team.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.SetTeamAdmin(createdTeam.Id, principal.UserId); err != nil {
+		return err
+	}
+
+	// the creator of a team owns it
+	err = s.database.SetMembership(database.MembershipORM{ResourceType: rbac.TeamResource, ResourceId: createdTeam.Id,
+		UserId: principal.UserId, Role: rbac.RoleOwner})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Team added", zap.Int32("team_id", createdTeam.Id), zap.Int32("admin_id", principal.UserId))
+	return nil
+}
+
+// UpdateTeam updates the descriptive fields of a team. The email, password and state of the team are
+// left untouched, credentials are replaced through ChangeTeamCredentials instead
+func (s basicService) UpdateTeam(ctx context.Context, team user_service.TeamORM) (err error) {
+	if _, err := s.getTeam(team.Id); err != nil {
+		return err
+	}
+
+	if err := s.database.UpdateTeam(team); err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrNotFound
+		}
+		return err
+	}
+
+	s.logger.Info("Team updated", zap.Int32("team_id", team.Id))
+	return nil
+}
+
+// ChangeTeamCredentials replaces the email and, if provided, the password of a team. The current
+// password of the team is checked whenever provided, callers omitting it must be allowed to manage the
+// credentials of the team. Every session of the team is then revoked
+func (s basicService) ChangeTeamCredentials(ctx context.Context, id int32, currentPassword, email, password, passwordConfirmed string) (err error) {
+	if err := validate.Var(email, "required,email"); err != nil {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return helper.ErrInvalidArgumentProvided
+	}
+
+	if password != passwordConfirmed {
+		s.logger.Error(helper.ErrPasswordsNotEqual.Error())
+		return helper.ErrPasswordsNotEqual
+	}
+
+	team, err := s.getTeam(id)
+	if err != nil {
+		return err
+	}
+
+	if currentPassword != "" && (team.Password == "" || !s.comparePasswords(team.Password, []byte(currentPassword))) {
+		s.logger.Error(helper.ErrInvalidPasswordProvided.Error(), zap.Int32("team_id", id))
+		return helper.ErrInvalidPasswordProvided
+	}
+
+	var hashedPassword string
+	if password != "" {
+		currentHash := team.Password
+		team.Email, team.Password = email, password
+		hashedPassword, err = s.validateAndHashTeamPassword(*team, []string{currentHash})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.database.UpdateTeamCredentials(id, email, hashedPassword); err != nil {
+		return err
+	}
+
+	// sessions established with the previous credentials are no longer trusted
+	if err := s.database.RevokeTeamRefreshTokens(id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Team credentials changed", zap.Int32("team_id", id), zap.Bool("password_changed", password != ""))
+	return nil
+}
+
+// DeleteTeam deletes a team alongside every role held on it and signs the team out of every session
+func (s basicService) DeleteTeam(ctx context.Context, id int32) (err error) {
+	team, err := s.getTeam(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.DeleteTeam(*team); err != nil {
+		return err
+	}
+
+	if err := s.database.RevokeTeamRefreshTokens(id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Team deleted", zap.Int32("team_id", id))
+	return nil
+}
+
+// SetTeamMember grants a role on a team to a given user, replacing any role they held on it
+func (s basicService) SetTeamMember(ctx context.Context, teamId, userId int32, role string) (err error) {
+	if _, err := s.getTeam(teamId); err != nil {
+		return err
+	}
+
+	return s.setMember(ctx, rbac.TeamResource, teamId, userId, role)
+}
+
+// RemoveTeamMember revokes the role a given user holds on a team
+func (s basicService) RemoveTeamMember(ctx context.Context, teamId, userId int32) (err error) {
+	return s.removeMember(rbac.TeamResource, teamId, userId)
+}
+
+// TeamLogIn authenticates a team with its own credentials. The issued tokens are scoped to the team
+// principal rather than to any of its members
+func (s basicService) TeamLogIn(ctx context.Context, name, password string) (team user_service.TeamORM, token auth.TokenPair, err error) {
+	if name == "" {
+		s.logger.Error(helper.ErrNoUsernameProvided.Error())
+		return team, token, helper.ErrNoUsernameProvided
+	}
+
+	if password == "" {
+		s.logger.Error(helper.ErrNoPasswordProvided.Error())
+		return team, token, helper.ErrNoPasswordProvided
+	}
+
+	// team log ins are throttled like the ones of users
+	throttles := loginThrottles(teamThrottlePrefix+name, auth.ClientFromContext(ctx))
+	if err := s.checkLoginThrottles(throttles); err != nil {
+		return team, token, err
+	}
+
+	err, currentTeam := s.database.GetTeamByName(name)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			s.logger.Error(helper.ErrInvalidUsernameProvided.Error())
+			s.recordLoginFailure(throttles)
+			return team, token, helper.ErrInvalidUsernameProvided
+		}
+		return team, token, err
+	}
+
+	// teams without a password can not log in
+	if currentTeam.Password == "" || !s.comparePasswords(currentTeam.Password, []byte(password)) {
+		s.logger.Error(helper.ErrInvalidPasswordProvided.Error(), zap.Int32("team_id", currentTeam.Id))
+		s.recordLoginFailure(throttles)
+		return team, token, helper.ErrInvalidPasswordProvided
+	}
+
+	if !currentTeam.IsActive {
+		s.logger.Error(helper.ErrForbidden.Error(), zap.Int32("team_id", currentTeam.Id))
+		return team, token, helper.ErrForbidden
+	}
+
+	// every log in starts a new refresh token family
+	token, err = s.issueTeamTokens(*currentTeam, tokenGrant{familyId: auth.NewTokenId(), client: auth.ClientFromContext(ctx)}, nil)
+	if err != nil {
+		return team, auth.TokenPair{}, err
+	}
+
+	s.resetLoginThrottle(teamThrottlePrefix + name)
+	s.logger.Info("Team logged in", zap.Int32("team_id", currentTeam.Id))
+	return sanitizeTeam(*currentTeam), token, nil
+}
+
+// ForgotTeamPassword issues a single use password reset token to the team owning a given email and
+// publishes a reset email to the lens_password_reset_email queue. Unknown emails are not reported
+// to the caller so that teams cannot be enumerated
+func (s basicService) ForgotTeamPassword(ctx context.Context, email string) (err error) {
+	if email == "" {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return helper.ErrInvalidArgumentProvided
+	}
+
+	err, team := s.database.GetTeamByEmail(email)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			s.logger.Info("Team password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	token, err := auth.NewOpaqueToken()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	expiresAt := time.Now().Add(config.Config.ResetTokenExpiry)
+	if err := s.database.SetTeamResetToken(team.Id, auth.HashToken(token), expiresAt); err != nil {
+		return err
+	}
+
+	link := strings.TrimSuffix(config.Config.AppUrl, "/") + "/team/password/reset?token=" + url.QueryEscape(token)
+	message := helper.EmailMessage{
+		TeamId:  team.Id,
+		Email:   team.Email,
+		Message: helper.TeamPasswordResetMessage(team.Name, link),
+	}
+
+	err = s.ProducerQueues.SendMessageToQueue(message.String(), "lens_password_reset_email")
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	s.logger.Info("Team password reset token issued", zap.Int32("team_id", team.Id))
+	return nil
+}
+
+// ResetTeamPassword consumes a team password reset token, replaces the password of the team and
+// revokes every session of the team
+func (s basicService) ResetTeamPassword(ctx context.Context, token, password, passwordConfirmed string) (err error) {
+	if token == "" {
+		s.logger.Error(helper.ErrInvalidResetToken.Error())
+		return helper.ErrInvalidResetToken
+	}
+
+	if password != passwordConfirmed {
+		s.logger.Error(helper.ErrPasswordsNotEqual.Error())
+		return helper.ErrPasswordsNotEqual
+	}
+
+	tokenHash := auth.HashToken(token)
+	err, team := s.database.GetTeamByResetToken(tokenHash)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return helper.ErrInvalidResetToken
+		}
+		return err
+	}
+
+	if team.ResetTokenExpiration == nil || team.ResetTokenExpiration.Before(time.Now()) {
+		s.logger.Error(helper.ErrResetTokenExpired.Error(), zap.Int32("team_id", team.Id))
+		return helper.ErrResetTokenExpired
+	}
+
+	currentHash := team.Password
+	team.Password = password
+	hashedPassword, err := s.validateAndHashTeamPassword(*team, []string{currentHash})
+	if err != nil {
+		return err
+	}
+
+	if err := s.database.ResetTeamPassword(team.Id, tokenHash, hashedPassword); err != nil {
+		return err
+	}
+
+	// sessions established with the previous password are no longer trusted
+	if err := s.database.RevokeTeamRefreshTokens(team.Id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Team password reset", zap.Int32("team_id", team.Id))
+	return nil
+}
+
+// validateAndHashTeamPassword checks the password of a team against the password policy and hashes it
+func (s basicService) validateAndHashTeamPassword(team user_service.TeamORM, previousHashes []string) (string, error) {
+	var hashes []string
+	for _, hash := range previousHashes {
+		if hash != "" {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	err := s.policy.Check(auth.PasswordCandidate{
+		Password:       team.Password,
+		Username:       team.Name,
+		Email:          team.Email,
+		PreviousHashes: hashes,
+	}, s.passwords)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return "", err
+	}
+
+	return s.hashAndSalt([]byte(team.Password))
+}
+
+// getTeam obtains a given team
+func (s basicService) getTeam(teamId int32) (*user_service.TeamORM, error) {
+	err, team := s.database.GetTeamById(teamId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, helper.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// sanitizeTeam strips credentials from a team object prior to returning it to callers
+func sanitizeTeam(team user_service.TeamORM) user_service.TeamORM {
+	team.Password = ""
+	team.ResetToken = ""
+	team.ResetTokenExpiration = nil
+	return team
+}
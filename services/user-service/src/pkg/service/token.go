@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// tokenGrant describes the refresh token family a token pair is issued under. Tokens issued
+// to first party clients carry no client id and are granted the default scopes of the user.
+// The device the grant is requested from is recorded on the session spanning the family
+type tokenGrant struct {
+	familyId string
+	clientId string
+	scopes   []string
+	client   auth.Client
+}
+
+// RefreshToken rotates a refresh token. The presented token is revoked and a new token pair
+// belonging to the same token family is issued. Presenting an already rotated token revokes
+// the entire family as the token is then assumed to be compromised
+func (s basicService) RefreshToken(ctx context.Context, refreshToken string) (token auth.TokenPair, err error) {
+	return s.rotateRefreshToken(ctx, refreshToken, "")
+}
+
+// LogOut revokes the family of refresh tokens the presented refresh token belongs to, thereby ending
+// its session
+func (s basicService) LogOut(ctx context.Context, refreshToken string) (err error) {
+	current, err := s.getRefreshToken(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	return s.database.RevokeRefreshTokenFamily(current.FamilyId)
+}
+
+// rotateRefreshToken exchanges a refresh token issued to a given client for a new token pair
+func (s basicService) rotateRefreshToken(ctx context.Context, refreshToken string, clientId string) (token auth.TokenPair, err error) {
+	current, err := s.getRefreshToken(refreshToken)
+	if err != nil {
+		return token, err
+	}
+
+	// refresh tokens may only be redeemed by the client they were issued to
+	if current.ClientId != clientId {
+		s.logger.Error(helper.ErrInvalidRefreshToken.Error())
+		return token, helper.ErrInvalidRefreshToken
+	}
+
+	if current.RevokedAt != nil {
+		s.logger.Error(helper.ErrRefreshTokenReused.Error())
+		if err := s.database.RevokeRefreshTokenFamily(current.FamilyId); err != nil {
+			return token, err
+		}
+		return token, helper.ErrRefreshTokenReused
+	}
+
+	if current.ExpiresAt == nil || current.ExpiresAt.Before(time.Now()) {
+		s.logger.Error(helper.ErrRefreshTokenExpired.Error())
+		return token, helper.ErrRefreshTokenExpired
+	}
+
+	grant := tokenGrant{familyId: current.FamilyId, clientId: current.ClientId, scopes: current.Scopes,
+		client: auth.ClientFromContext(ctx)}
+
+	// refresh tokens issued to teams are exchanged for tokens scoped to the team principal
+	if current.TeamId != 0 {
+		var team *user_service.TeamORM
+		team, err = s.getTeam(current.TeamId)
+		if err != nil {
+			return token, err
+		}
+		token, err = s.issueTeamTokens(*team, grant, current)
+	} else {
+		var user *user_service.UserORM
+		err, user = s.database.GetUserById(ctx, current.UserId)
+		if err != nil {
+			return token, err
+		}
+		token, err = s.issueTokens(*user, grant, current)
+	}
+	if err == helper.ErrRefreshTokenReused {
+		// another request rotated this token first
+		_ = s.database.RevokeRefreshTokenFamily(current.FamilyId)
+	}
+	return token, err
+}
+
+// issueTokens mints an access token as well as a refresh token for a given user
+func (s basicService) issueTokens(user user_service.UserORM, grant tokenGrant, previous *database.RefreshTokenORM) (auth.TokenPair, error) {
+	scopes := grant.scopes
+	if len(scopes) == 0 {
+		scopes = auth.ScopesForAccountType(user.UserAccountType)
+	}
+
+	accessToken, err := s.tokens.IssueAccessToken(user, scopes, grant.clientId, grant.familyId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return auth.TokenPair{}, err
+	}
+
+	token, err := s.issueRefreshToken(accessToken, user.Id, 0, grant, previous)
+	if err != nil {
+		return auth.TokenPair{}, err
+	}
+
+	if grant.clientId != "" {
+		token.Scope = strings.Join(scopes, " ")
+	}
+	return token, nil
+}
+
+// issueTeamTokens mints an access token as well as a refresh token for a given team. The tokens
+// are scoped to the team principal and carry no user identity
+func (s basicService) issueTeamTokens(team user_service.TeamORM, grant tokenGrant, previous *database.RefreshTokenORM) (auth.TokenPair, error) {
+	scopes := auth.ScopesForAccountType(auth.TeamAccountType)
+	accessToken, err := s.tokens.IssueTeamAccessToken(team, scopes, grant.familyId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return auth.TokenPair{}, err
+	}
+
+	return s.issueRefreshToken(accessToken, 0, team.Id, grant, previous)
+}
+
+// issueRefreshToken mints a refresh token for a given user or team and pairs it with an access token.
+// The refresh token joins the token family of the grant and, if a previous token is provided, replaces
+// it. A session is started alongside the first token of a family and kept alive by its successors
+func (s basicService) issueRefreshToken(accessToken string, userId int32, teamId int32, grant tokenGrant,
+	previous *database.RefreshTokenORM) (auth.TokenPair, error) {
+	refreshToken, err := auth.NewOpaqueToken()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return auth.TokenPair{}, err
+	}
+
+	expiresAt := time.Now().Add(s.tokens.RefreshTokenExpiry())
+	next := database.RefreshTokenORM{
+		UserId:    userId,
+		TeamId:    teamId,
+		FamilyId:  grant.familyId,
+		ClientId:  grant.clientId,
+		Scopes:    grant.scopes,
+		TokenHash: auth.HashToken(refreshToken),
+		ExpiresAt: &expiresAt,
+	}
+
+	now := time.Now()
+	if previous == nil {
+		err = s.database.CreateSession(database.SessionORM{
+			UserId:     userId,
+			TeamId:     teamId,
+			FamilyId:   grant.familyId,
+			ClientId:   grant.clientId,
+			Device:     grant.client.UserAgent,
+			IpAddress:  grant.client.IPAddress,
+			LastSeenAt: &now,
+			ExpiresAt:  &expiresAt,
+		})
+		if err == nil {
+			err = s.database.CreateRefreshToken(next)
+		}
+	} else {
+		err = s.database.RotateRefreshToken(*previous, next)
+		if err == nil {
+			_ = s.database.TouchSession(grant.familyId, grant.client.IPAddress, now, expiresAt)
+		}
+	}
+	if err != nil {
+		return auth.TokenPair{}, err
+	}
+
+	return s.tokens.NewTokenPair(accessToken, refreshToken), nil
+}
+
+// getRefreshToken obtains the persisted record of a refresh token
+func (s basicService) getRefreshToken(refreshToken string) (*database.RefreshTokenORM, error) {
+	if refreshToken == "" {
+		s.logger.Error(helper.ErrInvalidRefreshToken.Error())
+		return nil, helper.ErrInvalidRefreshToken
+	}
+
+	err, current := s.database.GetRefreshTokenByHash(auth.HashToken(refreshToken))
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, helper.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	return current, nil
+}
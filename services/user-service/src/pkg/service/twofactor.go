@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// EnrollTwoFactor generates a new time based one time password secret for the authenticated user.
+// The secret is only enabled once a code generated from it is confirmed
+func (s basicService) EnrollTwoFactor(ctx context.Context) (secret string, uri string, err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	err, user := s.database.GetUserById(ctx, principal.UserId)
+	if err != nil {
+		return "", "", err
+	}
+
+	secret, err = auth.NewTOTPSecret()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return "", "", err
+	}
+
+	ciphertext, err := s.secrets.Encrypt(secret)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return "", "", err
+	}
+
+	if err := s.database.SaveTwoFactorSecret(user.Id, ciphertext); err != nil {
+		return "", "", err
+	}
+
+	return secret, auth.TOTPURI(config.Config.Issuer, user.Email, secret), nil
+}
+
+// ConfirmTwoFactor enables two factor authentication for the authenticated user provided the code
+// was generated from their pending secret. A fresh set of recovery codes is returned
+func (s basicService) ConfirmTwoFactor(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := s.getTwoFactor(principal.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactor.EnabledAt != nil {
+		s.logger.Error(helper.ErrTwoFactorAlreadyEnabled.Error())
+		return nil, helper.ErrTwoFactorAlreadyEnabled
+	}
+
+	step, err := s.validateTOTP(twoFactor.Secret, code)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err = auth.NewRecoveryCodes()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	// only the digests of recovery codes are ever persisted
+	hashes := make([]string, 0, len(recoveryCodes))
+	for _, recoveryCode := range recoveryCodes {
+		hashes = append(hashes, auth.HashToken(auth.NormalizeRecoveryCode(recoveryCode)))
+	}
+
+	if err := s.database.EnableTwoFactor(principal.UserId, step, hashes); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Two factor authentication enabled", zap.Int32("user_id", principal.UserId))
+	return recoveryCodes, nil
+}
+
+// DisableTwoFactor disables two factor authentication for the authenticated user. A valid one time
+// password or recovery code must be presented
+func (s basicService) DisableTwoFactor(ctx context.Context, code string) (err error) {
+	principal, err := s.firstPartyPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifySecondFactor(principal.UserId, code); err != nil {
+		return err
+	}
+
+	if err := s.database.DisableTwoFactor(principal.UserId); err != nil {
+		return err
+	}
+
+	s.logger.Info("Two factor authentication disabled", zap.Int32("user_id", principal.UserId))
+	return nil
+}
+
+// VerifyTwoFactor completes a log in by exchanging the challenge issued for valid credentials as well
+// as a one time password or recovery code for a token pair
+func (s basicService) VerifyTwoFactor(ctx context.Context, challenge, code string) (user user_service.UserORM, token auth.TokenPair, err error) {
+	if challenge == "" {
+		s.logger.Error(helper.ErrInvalidChallengeToken.Error())
+		return user, token, helper.ErrInvalidChallengeToken
+	}
+
+	claims, err := s.tokens.ParseChallengeToken(challenge)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return user, token, err
+	}
+
+	err, currentUser := s.database.GetUserById(ctx, claims.UserId)
+	if err != nil {
+		return user, token, err
+	}
+
+	// codes are subject to the same throttling as passwords
+	throttles := loginThrottles(currentUser.UserName, auth.ClientFromContext(ctx))
+	if err := s.checkLoginThrottles(throttles); err != nil {
+		s.recordLoginActivity(ctx, currentUser.Id, currentUser.UserName, loginOutcome(err))
+		return user, token, err
+	}
+
+	if err := s.verifySecondFactor(currentUser.Id, code); err != nil {
+		if err == helper.ErrInvalidTwoFactorCode {
+			s.recordLoginFailure(throttles)
+			s.recordLoginActivity(ctx, currentUser.Id, currentUser.UserName, loginInvalidTwoFactor)
+		}
+		return user, token, err
+	}
+
+	if !currentUser.IsActive {
+		s.logger.Error(helper.ErrAccountNotVerified.Error(), zap.Int32("user_id", currentUser.Id))
+		return user, token, helper.ErrAccountNotVerified
+	}
+
+	// every log in starts a new refresh token family
+	token, err = s.issueTokens(*currentUser, tokenGrant{familyId: auth.NewTokenId(), client: auth.ClientFromContext(ctx)}, nil)
+	if err != nil {
+		return user_service.UserORM{}, auth.TokenPair{}, err
+	}
+
+	s.resetLoginThrottle(currentUser.UserName)
+	s.recordLoginActivity(ctx, currentUser.Id, currentUser.UserName, loginSucceeded)
+	return sanitizeUser(*currentUser), token, nil
+}
+
+// twoFactorEnabled reports whether a given user has to complete two factor authentication on log in
+func (s basicService) twoFactorEnabled(userId int32) (bool, error) {
+	err, twoFactor := s.database.GetTwoFactor(userId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return twoFactor.EnabledAt != nil, nil
+}
+
+// verifySecondFactor checks a one time password or, failing that, redeems a recovery code of a user
+// whose two factor authentication is enabled
+func (s basicService) verifySecondFactor(userId int32, code string) error {
+	twoFactor, err := s.getTwoFactor(userId)
+	if err != nil {
+		return err
+	}
+
+	if twoFactor.EnabledAt == nil {
+		s.logger.Error(helper.ErrTwoFactorNotEnabled.Error())
+		return helper.ErrTwoFactorNotEnabled
+	}
+
+	if step, err := s.validateTOTP(twoFactor.Secret, code); err == nil {
+		return s.database.UseTwoFactorStep(userId, step)
+	}
+
+	if code == "" {
+		return helper.ErrInvalidTwoFactorCode
+	}
+
+	return s.database.RedeemRecoveryCode(userId, auth.HashToken(auth.NormalizeRecoveryCode(code)))
+}
+
+// validateTOTP decrypts a two factor secret and checks a one time password against it
+func (s basicService) validateTOTP(ciphertext string, code string) (int64, error) {
+	secret, err := s.secrets.Decrypt(ciphertext)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return 0, err
+	}
+
+	step, ok := auth.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		s.logger.Error(helper.ErrInvalidTwoFactorCode.Error())
+		return 0, helper.ErrInvalidTwoFactorCode
+	}
+
+	return step, nil
+}
+
+// getTwoFactor obtains the two factor secret of a given user
+func (s basicService) getTwoFactor(userId int32) (*database.TwoFactorORM, error) {
+	err, twoFactor := s.database.GetTwoFactor(userId)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, helper.ErrTwoFactorNotEnabled
+		}
+		return nil, err
+	}
+
+	return twoFactor, nil
+}
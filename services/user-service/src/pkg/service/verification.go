@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/config"
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// VerifyEmail activates the account a signed verification token was issued for. Tokens issued
+// for an address the user no longer owns are rejected
+func (s basicService) VerifyEmail(ctx context.Context, token string) (err error) {
+	if token == "" {
+		s.logger.Error(helper.ErrInvalidVerificationToken.Error())
+		return helper.ErrInvalidVerificationToken
+	}
+
+	claims, err := s.tokens.ParseVerificationToken(token)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	if err := s.database.ActivateUser(claims.UserId, claims.Email); err != nil {
+		return err
+	}
+
+	s.logger.Info("User activated", zap.Int32("user_id", claims.UserId))
+	return nil
+}
+
+// ResendVerification sends a new verification link to the owner of an inactive account. Unknown
+// emails and active accounts are not reported to the caller so that accounts cannot be enumerated
+func (s basicService) ResendVerification(ctx context.Context, email string) (err error) {
+	if email == "" {
+		s.logger.Error(helper.ErrInvalidArgumentProvided.Error())
+		return helper.ErrInvalidArgumentProvided
+	}
+
+	err, user := s.database.GetUserByEmail(ctx, email)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			s.logger.Info("Verification requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	if user.IsActive {
+		return nil
+	}
+
+	return s.sendVerificationEmail(*user)
+}
+
+// sendVerificationEmail publishes a welcome email carrying a signed verification link to the
+// lens_welcome_email queue
+func (s basicService) sendVerificationEmail(user user_service.UserORM) error {
+	token, err := s.tokens.IssueVerificationToken(user)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	link := strings.TrimSuffix(config.Config.PublicUrl, "/") + "/v1/user/verify?token=" + url.QueryEscape(token)
+	message := helper.EmailMessage{
+		UserId:  user.Id,
+		Email:   user.Email,
+		Message: helper.VerificationMessage(user.FirstName, user.LastName, link),
+	}
+
+	err = s.ProducerQueues.SendMessageToQueue(message.String(), "lens_welcome_email")
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
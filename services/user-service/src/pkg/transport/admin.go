@@ -0,0 +1,91 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// Unlock User godoc
+// @Summary Hits the unlock user api endpoint
+// @Description Clears the failed log in attempts and any temporary lock of the account of a user.
+// @Description Reserved to platform staff
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "user id"
+// @Router /v1/admin/users/{id}/unlock [post]
+// @Success 200
+func UnlockUser(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/admin/users/{id}/unlock").Handler(httptransport.NewServer(
+		e.UnlockUserEndpoint,
+		decodeUnlockUserRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Restore User godoc
+// @Summary Hits the restore user api endpoint
+// @Description Restores a deleted user provided the deletion grace period has not elapsed. Reserved
+// @Description to platform staff
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "user id"
+// @Router /v1/admin/users/{id}/restore [post]
+// @Success 200
+func RestoreUser(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/admin/users/{id}/restore").Handler(httptransport.NewServer(
+		e.RestoreUserEndpoint,
+		decodeRestoreUserRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Impersonate godoc
+// @Summary Hits the impersonate api endpoint
+// @Description Issues a time boxed access token letting a staff member act on behalf of a user. The
+// @Description token can neither change the password, email or two factor settings of the user nor mint
+// @Description api keys or oauth grants. Every request made with it is audited. Reserved to platform staff
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "user id"
+// @Router /v1/admin/users/{id}/impersonate [post]
+// @Success 200
+func Impersonate(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/admin/users/{id}/impersonate").Handler(httptransport.NewServer(
+		e.ImpersonateEndpoint,
+		decodeImpersonateRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeUnlockUserRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.UnlockUserRequest{Id: id}, nil
+}
+
+func decodeRestoreUserRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.RestoreUserRequest{Id: id}, nil
+}
+
+func decodeImpersonateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.ImpersonateRequest{UserId: id}, nil
+}
@@ -0,0 +1,110 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// Create Api Key godoc
+// @Summary Hits the create api key api endpoint
+// @Description Issues an api key belonging to the authenticated user or to one of their teams.
+// @Description The plain text key is only returned once
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/apikeys [post]
+// @Success 200
+func CreateAPIKey(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/apikeys").Handler(httptransport.NewServer(
+		e.CreateAPIKeyEndpoint,
+		decodeCreateAPIKeyRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// List Api Keys godoc
+// @Summary Hits the list api keys api endpoint
+// @Description Lists the api keys belonging to the authenticated user and optionally to one of their teams
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param team_id query int false "team id"
+// @Router /v1/apikeys [get]
+// @Success 200
+func ListAPIKeys(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/apikeys").Handler(httptransport.NewServer(
+		e.ListAPIKeysEndpoint,
+		decodeListAPIKeysRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Revoke Api Key godoc
+// @Summary Hits the revoke api key api endpoint
+// @Description Revokes an api key belonging to the authenticated user or to one of their teams
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/apikeys/{id} [delete]
+// @Success 200
+func RevokeAPIKey(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/apikeys/{id}").Handler(httptransport.NewServer(
+		e.RevokeAPIKeyEndpoint,
+		decodeRevokeAPIKeyRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateAPIKeyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeListAPIKeysRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.ListAPIKeysRequest
+	if value := r.URL.Query().Get("team_id"); value != "" {
+		teamId, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, utils.ErrInvalidArgumentProvided
+		}
+		req.TeamId = int32(teamId)
+	}
+	return req, nil
+}
+
+func decodeRevokeAPIKeyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.RevokeAPIKeyRequest{Id: id}, nil
+}
+
+// decodeIdParam obtains a numeric identifier from the route variables
+func decodeIdParam(r *http.Request, param string) (int32, error) {
+	value, ok := mux.Vars(r)[param]
+	if !ok {
+		return 0, utils.ErrBadRouting
+	}
+
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, utils.ErrInvalidArgumentProvided
+	}
+	return int32(id), nil
+}
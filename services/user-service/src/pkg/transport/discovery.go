@@ -0,0 +1,76 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// OpenID Configuration godoc
+// @Summary Hits the OpenID Connect discovery api endpoint
+// @Description Describes the authorization server, its endpoints and the algorithm tokens are signed with
+// @Tags HTTP API
+// @Produce json
+// @Router /.well-known/openid-configuration [get]
+// @Success 200
+func GetOpenIDConfiguration(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/.well-known/openid-configuration").Handler(httptransport.NewServer(
+		e.GetOpenIDConfigurationEndpoint,
+		decodeEmptyRequest,
+		encodeOpenIDConfigurationResponse,
+		options...,
+	))
+}
+
+// JSON Web Key Set godoc
+// @Summary Hits the jwks api endpoint
+// @Description Publishes the public keys issued tokens may be verified with, including retired keys
+// @Description whose tokens have not yet expired
+// @Tags HTTP API
+// @Produce json
+// @Router /.well-known/jwks.json [get]
+// @Success 200
+func GetJSONWebKeySet(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/.well-known/jwks.json").Handler(httptransport.NewServer(
+		e.GetJSONWebKeySetEndpoint,
+		decodeEmptyRequest,
+		encodeJSONWebKeySetResponse,
+		options...,
+	))
+}
+
+func decodeEmptyRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+// encodeOpenIDConfigurationResponse encodes the bare discovery document
+func encodeOpenIDConfigurationResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(serviceendpoint.GetOpenIDConfigurationResponse)
+	if resp.Err != nil {
+		encodeError(ctx, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(resp.Configuration)
+}
+
+// encodeJSONWebKeySetResponse encodes the bare key set. Verifiers may cache it briefly and are
+// expected to refetch it when encountering an unknown key id
+func encodeJSONWebKeySetResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(serviceendpoint.GetJSONWebKeySetResponse)
+	if resp.Err != nil {
+		encodeError(ctx, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	return json.NewEncoder(w).Encode(resp.Keys)
+}
@@ -0,0 +1,64 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// Change Email godoc
+// @Summary Hits the change email api endpoint
+// @Description Records a new email address as pending for the authenticated user and emails a
+// @Description confirmation link to it. The current address is notified of the request
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/email [post]
+// @Success 200
+func ChangeEmail(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/email").Handler(httptransport.NewServer(
+		e.ChangeEmailEndpoint,
+		decodeChangeEmailRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Confirm Email Change godoc
+// @Summary Hits the confirm email change api endpoint
+// @Description Confirms a pending email change by means of the token sent to the new address
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/email/confirm [post]
+// @Success 200
+func ConfirmEmailChange(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/email/confirm").Handler(httptransport.NewServer(
+		e.ConfirmEmailChangeEndpoint,
+		decodeConfirmEmailChangeRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeChangeEmailRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeConfirmEmailChangeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.ConfirmEmailChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
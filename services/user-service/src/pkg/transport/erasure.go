@@ -0,0 +1,65 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// Erase User godoc
+// @Summary Hits the erase user api endpoint
+// @Description Anonymizes every record held about a user and publishes a user.erased event so that
+// @Description downstream services may do the same. Teams and groups solely administered by the user are
+// @Description handed over to another member. Returns a signed receipt of the erasure
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "user id"
+// @Router /v1/user/{id}/erasure [post]
+// @Success 200
+func EraseUser(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/{id}/erasure").Handler(httptransport.NewServer(
+		e.EraseUserEndpoint,
+		decodeEraseUserRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Verify Erasure Receipt godoc
+// @Summary Hits the verify erasure receipt api endpoint
+// @Description Checks that an erasure receipt was issued by the service and returns the erasure it attests
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/erasure/verify [post]
+// @Success 200
+func VerifyErasureReceipt(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/erasure/verify").Handler(httptransport.NewServer(
+		e.VerifyErasureReceiptEndpoint,
+		decodeVerifyErasureReceiptRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeEraseUserRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.EraseUserRequest{Id: id}, nil
+}
+
+func decodeVerifyErasureReceiptRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.VerifyErasureReceiptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
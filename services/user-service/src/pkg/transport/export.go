@@ -0,0 +1,88 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// Request Data Export godoc
+// @Summary Hits the request data export api endpoint
+// @Description Schedules an export of every record held about a user. A message is published once the
+// @Description export may be downloaded. Users may export their own data while platform staff may export
+// @Description the data of any user
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "user id"
+// @Router /v1/user/{id}/exports [post]
+// @Success 200
+func RequestDataExport(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/{id}/exports").Handler(httptransport.NewServer(
+		e.RequestDataExportEndpoint,
+		decodeRequestDataExportRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Download Data Export godoc
+// @Summary Hits the download data export api endpoint
+// @Description Downloads a completed data export as a zip archive holding one JSON file per kind of record
+// @Tags HTTP API
+// @Produce application/zip
+// @Param id path int true "user id"
+// @Param export_id path int true "export id"
+// @Router /v1/user/{id}/exports/{export_id}/download [get]
+// @Success 200
+func DownloadDataExport(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/user/{id}/exports/{export_id}/download").Handler(httptransport.NewServer(
+		e.DownloadDataExportEndpoint,
+		decodeDownloadDataExportRequest,
+		encodeDownloadDataExportResponse,
+		options...,
+	))
+}
+
+func decodeRequestDataExportRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.RequestDataExportRequest{Id: id}, nil
+}
+
+func decodeDownloadDataExportRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	exportId, err := decodeIdParam(r, "export_id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.DownloadDataExportRequest{Id: id, ExportId: exportId}, nil
+}
+
+// encodeDownloadDataExportResponse writes the archive of an export as an attachment
+func encodeDownloadDataExportResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(serviceendpoint.DownloadDataExportResponse)
+	if resp.Err != nil {
+		encodeError(ctx, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"lens-data-export-%d.zip\"", resp.ExportId))
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Archive)))
+	w.Header().Set("Cache-Control", "no-store")
+	_, err := w.Write(resp.Archive)
+	return err
+}
@@ -0,0 +1,174 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// Create Group godoc
+// @Summary Hits the create group api endpoint
+// @Description Creates a group owned by the authenticated user
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/group/create [post]
+// @Success 200
+func CreateGroup(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/group/create").Handler(httptransport.NewServer(
+		e.CreateGroupEndpoint,
+		decodeCreateGroupRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Update Group godoc
+// @Summary Hits the update group api endpoint
+// @Description Updates a group on behalf of one of its owners or admins
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Group id"
+// @Router /v1/group/{id} [put]
+// @Success 200
+func UpdateGroup(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("PUT").Path("/v1/group/{id}").Handler(httptransport.NewServer(
+		e.UpdateGroupEndpoint,
+		decodeUpdateGroupRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Delete Group godoc
+// @Summary Hits the delete group api endpoint
+// @Description Deletes a group alongside every role held on it. Only owners of the group may delete it
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Group id"
+// @Router /v1/group/{id} [delete]
+// @Success 200
+func DeleteGroup(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/group/{id}").Handler(httptransport.NewServer(
+		e.DeleteGroupEndpoint,
+		decodeDeleteGroupRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Set Group Member godoc
+// @Summary Hits the set group member api endpoint
+// @Description Grants one of the owner, admin, member or advisor roles on a group to a user. Granting
+// @Description or revoking the owner and admin roles is restricted to owners
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Group id"
+// @Param user_id path int true "User id"
+// @Router /v1/group/{id}/members/{user_id} [put]
+// @Success 200
+func SetGroupMember(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("PUT").Path("/v1/group/{id}/members/{user_id}").Handler(httptransport.NewServer(
+		e.SetGroupMemberEndpoint,
+		decodeSetGroupMemberRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Remove Group Member godoc
+// @Summary Hits the remove group member api endpoint
+// @Description Revokes the role a user holds on a group. Users may always leave a group on their own
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Group id"
+// @Param user_id path int true "User id"
+// @Router /v1/group/{id}/members/{user_id} [delete]
+// @Success 200
+func RemoveGroupMember(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/group/{id}/members/{user_id}").Handler(httptransport.NewServer(
+		e.RemoveGroupMemberEndpoint,
+		decodeRemoveGroupMemberRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateGroupRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.CreateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeUpdateGroupRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req serviceendpoint.UpdateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	// the group id may be omitted from the body but must otherwise match the route
+	if req.Group.Id != 0 && req.Group.Id != id {
+		return nil, utils.ErrInconsistentIDs
+	}
+	req.Group.Id = id
+	return req, nil
+}
+
+func decodeDeleteGroupRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.DeleteGroupRequest{Id: id}, nil
+}
+
+func decodeSetGroupMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	groupId, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := decodeIdParam(r, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req serviceendpoint.SetGroupMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	req.GroupId = groupId
+	req.UserId = userId
+	return req, nil
+}
+
+func decodeRemoveGroupMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	groupId, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := decodeIdParam(r, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.RemoveGroupMemberRequest{GroupId: groupId, UserId: userId}, nil
+}
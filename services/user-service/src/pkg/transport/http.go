@@ -8,6 +8,7 @@ package transport
 import (
 	"context"
 	"encoding/json"
+	kitjwt "github.com/go-kit/kit/auth/jwt"
 	"github.com/go-kit/kit/metrics"
 	"github.com/gorilla/mux"
 	stdopentracing "github.com/opentracing/opentracing-go"
@@ -26,6 +27,7 @@ import (
 
 	_ "github.com/go-kit/kit/endpoint"
 
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
 	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
 	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
 	service "github.com/LensPlatform/Lens/services/user-service/src/pkg/service"
@@ -37,10 +39,15 @@ func NewHTTPHandler(s service.Service, endpoints serviceendpoint.Set,
 	duration metrics.Histogram, otTracer stdopentracing.Tracer,
 	zipkinTracer *stdzipkin.Tracer, logger *zap.Logger) http.Handler {
 	r := mux.NewRouter()
-	e := serviceendpoint.MakeServerEndpoints(s, logger, duration, otTracer, zipkinTracer)
+	e := endpoints
 	var options = []httptransport.ServerOption{
 		httptransport.ServerErrorHandler(utils.NewTransportHandler(logger)),
 		httptransport.ServerErrorEncoder(encodeError),
+		// bearer tokens and api keys are moved into the request context and verified by the endpoint layer,
+		// the address and user agent of the caller are recorded alongside. Requests are scoped to the
+		// default account until the endpoint layer scopes them to the account of the principal
+		httptransport.ServerBefore(kitjwt.HTTPToContext(), auth.HTTPAPIKeyToContext(), auth.HTTPClientToContext(),
+			auth.HTTPAccountToContext()),
 	}
 	if zipkinTracer != nil {
 		// Zipkin HTTP Server Trace can either be instantiated per endpoint with a
@@ -52,9 +59,62 @@ func NewHTTPHandler(s service.Service, endpoints serviceendpoint.Set,
 	}
 
 	CreateUserEndpoint(r, e, options)
+	UpdateUser(r, e, options)
+	DeleteUser(r, e, options)
 	GetUserByUsername(r, e, options)
 	GetUserById(r, e, options)
 	LogInUser(r, e, options)
+	RefreshToken(r, e, options)
+	LogOut(r, e, options)
+	CreateAPIKey(r, e, options)
+	ListAPIKeys(r, e, options)
+	RevokeAPIKey(r, e, options)
+	RegisterOAuthClient(r, e, options)
+	Authorize(r, e, options)
+	GrantConsent(r, e, options)
+	ExchangeToken(r, e, options)
+	GetOpenIDConfiguration(r, e, options)
+	GetJSONWebKeySet(r, e, options)
+	ForgotPassword(r, e, options)
+	ResetPassword(r, e, options)
+	ChangePassword(r, e, options)
+	ChangeEmail(r, e, options)
+	ConfirmEmailChange(r, e, options)
+	VerifyEmail(r, e, options)
+	ResendVerification(r, e, options)
+	EnrollTwoFactor(r, e, options)
+	ConfirmTwoFactor(r, e, options)
+	DisableTwoFactor(r, e, options)
+	VerifyTwoFactor(r, e, options)
+	UnlockUser(r, e, options)
+	Impersonate(r, e, options)
+	RestoreUser(r, e, options)
+	GetLoginActivity(r, e, options)
+	RequestDataExport(r, e, options)
+	DownloadDataExport(r, e, options)
+	EraseUser(r, e, options)
+	VerifyErasureReceipt(r, e, options)
+	ListUsers(r, e, options)
+	ListTeams(r, e, options)
+	ListGroups(r, e, options)
+	GetUsersByType(r, e, options)
+	ListSessions(r, e, options)
+	RevokeSession(r, e, options)
+	RevokeAllSessions(r, e, options)
+	CreateTeam(r, e, options)
+	UpdateTeam(r, e, options)
+	ChangeTeamCredentials(r, e, options)
+	DeleteTeam(r, e, options)
+	SetTeamMember(r, e, options)
+	RemoveTeamMember(r, e, options)
+	TeamLogIn(r, e, options)
+	ForgotTeamPassword(r, e, options)
+	ResetTeamPassword(r, e, options)
+	CreateGroup(r, e, options)
+	UpdateGroup(r, e, options)
+	DeleteGroup(r, e, options)
+	SetGroupMember(r, e, options)
+	RemoveGroupMember(r, e, options)
 	GetServiceMetrics(r)
 	GetSwaggerDocumentation(r, logger)
 
@@ -87,16 +147,19 @@ func GetServiceMetrics(r *mux.Router) *mux.Route {
 }
 
 // LogIn User godoc
-// @Summary Hits the login user api endpoint
+// @Summary Hits the token issuance api endpoint
 // @Description Attempts to log a user into the system given the user exists in the backend data store
+// @Description and issues an access and refresh token pair. Credentials are accepted either as a
+// @Description json body or through basic authentication. Users with two factor authentication enabled
+// @Description are issued a challenge token to be completed through /v1/auth/2fa instead
 // @Tags HTTP API
 // @Accept json
 // @Produce json
-// @Router /v1/user/login [get]
+// @Router /v1/auth/token [post]
 // @Success 200
 func LogInUser(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) {
 	GetUserByEmail(r, e, options)
-	r.Methods("GET").Path("/v1/user/login").Handler(httptransport.NewServer(
+	r.Methods("POST").Path("/v1/auth/token").Handler(httptransport.NewServer(
 		e.LoginEndpoint,
 		decodeLogin,
 		encodeResponse,
@@ -104,6 +167,41 @@ func LogInUser(r *mux.Router, e serviceendpoint.Set, options []httptransport.Ser
 	))
 }
 
+// Refresh Token godoc
+// @Summary Hits the refresh token api endpoint
+// @Description Exchanges a refresh token for a new access and refresh token pair. The presented
+// @Description refresh token is rotated and cannot be used again
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/auth/refresh [post]
+// @Success 200
+func RefreshToken(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/auth/refresh").Handler(httptransport.NewServer(
+		e.RefreshTokenEndpoint,
+		decodeRefreshTokenRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Log Out godoc
+// @Summary Hits the log out api endpoint
+// @Description Revokes the presented refresh token as well as every token rotated from the same log in
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/auth/logout [post]
+// @Success 200
+func LogOut(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/auth/logout").Handler(httptransport.NewServer(
+		e.LogOutEndpoint,
+		decodeRefreshTokenRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
 // Get User by Email godoc
 // @Summary Hits the get user by email api endpoint
 // @Description Obtains a user in the backend datastore based on the provided email
@@ -172,6 +270,45 @@ func CreateUserEndpoint(r *mux.Router, e serviceendpoint.Set, options []httptran
 	))
 }
 
+// Update User godoc
+// @Summary Hits the update user api endpoint
+// @Description Updates the fields of a user listed by the update mask and returns the updated user. Mask
+// @Description paths name the fields of the user, e.g. {"user": {"FirstName": "Jane"}, "update_mask":
+// @Description {"paths": ["FirstName"]}}. The id, email, password and account type can not be updated
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "User id"
+// @Router /v1/user/{id} [patch]
+// @Success 200
+func UpdateUser(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("PATCH").Path("/v1/user/{id}").Handler(httptransport.NewServer(
+		e.UpdateUserEndpoint,
+		decodeUpdateUserRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Delete User godoc
+// @Summary Hits the delete user api endpoint
+// @Description Deletes a user and revokes every credential of the user. The user may be restored by
+// @Description platform staff until the deletion grace period elapses, after which the user, their
+// @Description profile, address, education and experience are permanently deleted
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "User id"
+// @Router /v1/user/{id} [delete]
+// @Success 200
+func DeleteUser(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/user/{id:[0-9]+}").Handler(httptransport.NewServer(
+		e.DeleteUserEndpoint,
+		decodeDeleteUserRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
 // errorer is implemented by all concrete response types that may contain
 // errors. It allows us to change the HTTP response code without needing to
 // trigger an endpoint (transport-level) error. For more information, read the
@@ -205,17 +342,49 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 	}
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(codeFrom(err))
-	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+
+	body := map[string]interface{}{
 		"error": err.Error(),
-	})
+	}
+	// password policy violations are reported rule by rule
+	if policyErr, ok := err.(*utils.PasswordPolicyError); ok {
+		body["violations"] = policyErr.Violations
+	}
+	_ = json.NewEncoder(w).Encode(body)
 }
 
 func codeFrom(err error) int {
+	if _, ok := err.(*utils.PasswordPolicyError); ok {
+		return http.StatusBadRequest
+	}
+
 	switch err {
 	case utils.ErrNotFound:
 		return http.StatusNotFound
-	case utils.ErrAlreadyExists, utils.ErrInconsistentIDs:
+	case utils.ErrAlreadyExists, utils.ErrInconsistentIDs, utils.ErrNoUsernameProvided, utils.ErrNoPasswordProvided,
+		utils.ErrInvalidArgumentProvided, utils.ErrInvalidScope, utils.ErrInvalidRequest, utils.ErrInvalidGrant,
+		utils.ErrUnsupportedGrantType, utils.ErrUnsupportedResponseType, utils.ErrPasswordsNotEqual,
+		utils.ErrInvalidResetToken, utils.ErrResetTokenExpired, utils.ErrInvalidEmailChangeToken, utils.ErrEmailChangeExpired,
+		utils.ErrInvalidVerificationToken, utils.ErrVerificationTokenExpired, utils.ErrTwoFactorAlreadyEnabled,
+		utils.ErrTwoFactorNotEnabled, utils.ErrInvalidPageToken, utils.ErrInvalidRole, utils.ErrLastOwner,
+		utils.ErrProtectedField, utils.ErrInvalidErasureReceipt, utils.ErrInvalidSortKey, utils.ErrUnsupportedFilter:
 		return http.StatusBadRequest
+	case utils.ErrInvalidUsernameProvided, utils.ErrInvalidPasswordProvided, utils.ErrInvalidRefreshToken,
+		utils.ErrRefreshTokenExpired, utils.ErrRefreshTokenReused, utils.ErrUnauthorized, utils.ErrAccessTokenExpired,
+		utils.ErrMissingCredentials, utils.ErrInvalidAPIKey, utils.ErrAPIKeyExpired, utils.ErrInvalidClient,
+		utils.ErrInvalidTwoFactorCode, utils.ErrInvalidChallengeToken, utils.ErrChallengeTokenExpired,
+		utils.ErrSessionRevoked:
+		return http.StatusUnauthorized
+	case utils.ErrForbidden, utils.ErrConsentRequired, utils.ErrAccountNotVerified, utils.ErrImpersonationForbidden:
+		return http.StatusForbidden
+	case utils.ErrTooManyLoginAttempts:
+		return http.StatusTooManyRequests
+	case utils.ErrLoginLocked:
+		return http.StatusLocked
+	case utils.ErrRestorePeriodElapsed:
+		return http.StatusGone
+	case utils.ErrExportNotReady:
+		return http.StatusConflict
 	default:
 		return http.StatusInternalServerError
 	}
@@ -232,6 +401,33 @@ func decodeCreateUserRequest(_ context.Context, r *http.Request) (interface{}, e
 	return req, nil
 }
 
+func decodeUpdateUserRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req serviceendpoint.UpdateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	// the user id may be omitted from the body but must otherwise match the route
+	if req.User.Id != 0 && req.User.Id != id {
+		return nil, utils.ErrInconsistentIDs
+	}
+	req.User.Id = id
+	return req, nil
+}
+
+func decodeDeleteUserRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.DeleteUserRequest{Id: id}, nil
+}
+
 func decodeGetUserRequestById(_ context.Context, r *http.Request) (interface{}, error) {
 	req, err := decodeGetUserRequest(r, "id")
 	if err != nil {
@@ -256,11 +452,28 @@ func decodeGetUserRequestByUsername(_ context.Context, r *http.Request) (interfa
 	return req, nil
 }
 
+// decodeLogin decodes log in credentials either from the basic authentication header
+// or from a JSON-encoded request body
 func decodeLogin(_ context.Context, r *http.Request) (interface{}, error) {
 	var req serviceendpoint.LoginRequest
-	params := r.URL.Query()
-	req.Username = params.Get("username")
-	req.Password = params.Get("password")
+	if username, password, ok := r.BasicAuth(); ok {
+		req.Username = username
+		req.Password = password
+		return req, nil
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// decodeRefreshTokenRequest decodes a JSON-encoded refresh token from the HTTP request body
+func decodeRefreshTokenRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
@@ -0,0 +1,162 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	database "github.com/LensPlatform/Lens/services/user-service/src/pkg/database/postgresql"
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// List Users godoc
+// @Summary Hits the list users api endpoint
+// @Description Lists the users of the account of the caller. Pages are obtained by passing back the
+// @Description next_cursor of the previous page, with the same sort_by and order
+// @Tags HTTP API
+// @Produce json
+// @Param limit query int false "amount of users per page"
+// @Param cursor query string false "cursor of the page to obtain"
+// @Param sort_by query string false "id, created_at or name"
+// @Param order query string false "asc or desc"
+// @Param account_type query string false "account type of the users"
+// @Param is_active query bool false "whether the users are active"
+// @Param created_after query string false "RFC 3339 lower bound of the creation time"
+// @Param created_before query string false "RFC 3339 upper bound of the creation time"
+// @Router /v1/users [get]
+// @Success 200
+func ListUsers(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/users").Handler(httptransport.NewServer(
+		e.ListUsersEndpoint,
+		decodeListRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// List Teams godoc
+// @Summary Hits the list teams api endpoint
+// @Description Lists teams. Pages are obtained by passing back the next_cursor of the previous page,
+// @Description with the same sort_by and order
+// @Tags HTTP API
+// @Produce json
+// @Param limit query int false "amount of teams per page"
+// @Param cursor query string false "cursor of the page to obtain"
+// @Param sort_by query string false "id, created_at or name"
+// @Param order query string false "asc or desc"
+// @Param account_type query string false "type of the teams"
+// @Param is_active query bool false "whether the teams are active"
+// @Param created_after query string false "RFC 3339 lower bound of the creation time"
+// @Param created_before query string false "RFC 3339 upper bound of the creation time"
+// @Param industry query string false "industry of the teams"
+// @Param tags query string false "comma separated tags the teams must all hold"
+// @Router /v1/teams [get]
+// @Success 200
+func ListTeams(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/teams").Handler(httptransport.NewServer(
+		e.ListTeamsEndpoint,
+		decodeListRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// List Groups godoc
+// @Summary Hits the list groups api endpoint
+// @Description Lists groups. Pages are obtained by passing back the next_cursor of the previous page,
+// @Description with the same sort_by and order
+// @Tags HTTP API
+// @Produce json
+// @Param limit query int false "amount of groups per page"
+// @Param cursor query string false "cursor of the page to obtain"
+// @Param sort_by query string false "id, created_at or name"
+// @Param order query string false "asc or desc"
+// @Param account_type query string false "type of the groups"
+// @Param created_after query string false "RFC 3339 lower bound of the creation time"
+// @Param created_before query string false "RFC 3339 upper bound of the creation time"
+// @Param tags query string false "comma separated tags the groups must all hold"
+// @Router /v1/groups [get]
+// @Success 200
+func ListGroups(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/groups").Handler(httptransport.NewServer(
+		e.ListGroupsEndpoint,
+		decodeListRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// decodeListRequest decodes the paging, sorting and filtering parameters shared by listings
+func decodeListRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	params := r.URL.Query()
+	req := serviceendpoint.ListRequest{
+		Cursor: params.Get("cursor"),
+		Query: database.ListQuery{
+			SortBy:      params.Get("sort_by"),
+			AccountType: params.Get("account_type"),
+			Industry:    params.Get("industry"),
+		},
+	}
+
+	if value := params.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, utils.ErrInvalidArgumentProvided
+		}
+		req.Query.Limit = limit
+	}
+
+	switch params.Get("order") {
+	case "", "asc":
+	case "desc":
+		req.Query.Descending = true
+	default:
+		return nil, utils.ErrInvalidArgumentProvided
+	}
+
+	if value := params.Get("is_active"); value != "" {
+		isActive, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, utils.ErrInvalidArgumentProvided
+		}
+		req.Query.IsActive = &isActive
+	}
+
+	var err error
+	if req.Query.CreatedAfter, err = decodeTimeParam(params.Get("created_after")); err != nil {
+		return nil, err
+	}
+	if req.Query.CreatedBefore, err = decodeTimeParam(params.Get("created_before")); err != nil {
+		return nil, err
+	}
+
+	for _, value := range params["tags"] {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				req.Query.Tags = append(req.Query.Tags, tag)
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// decodeTimeParam parses an optional RFC 3339 query parameter
+func decodeTimeParam(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, utils.ErrInvalidArgumentProvided
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,51 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// Get Login Activity godoc
+// @Summary Hits the get login activity api endpoint
+// @Description Lists the log in attempts made for a user, most recent first. Users may review their
+// @Description own activity while platform staff may review the activity of any user
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "user id"
+// @Param page_size query int false "amount of attempts per page"
+// @Param page_token query string false "token of the page to obtain"
+// @Router /v1/user/{id}/login-activity [get]
+// @Success 200
+func GetLoginActivity(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/user/{id}/login-activity").Handler(httptransport.NewServer(
+		e.GetLoginActivityEndpoint,
+		decodeGetLoginActivityRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeGetLoginActivityRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	req := serviceendpoint.GetLoginActivityRequest{Id: id, PageToken: r.URL.Query().Get("page_token")}
+	if value := r.URL.Query().Get("page_size"); value != "" {
+		pageSize, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, utils.ErrInvalidArgumentProvided
+		}
+		req.PageSize = pageSize
+	}
+	return req, nil
+}
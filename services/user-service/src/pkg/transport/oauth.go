@@ -0,0 +1,180 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/LensPlatform/Lens/services/user-service/src/pkg/auth"
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// Register OAuth Client godoc
+// @Summary Hits the register oauth client api endpoint
+// @Description Registers a third party application against the oauth2 authorization server.
+// @Description The client secret of confidential clients is only returned once
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/oauth/clients [post]
+// @Success 200
+func RegisterOAuthClient(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/oauth/clients").Handler(httptransport.NewServer(
+		e.RegisterOAuthClientEndpoint,
+		decodeRegisterOAuthClientRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Authorize godoc
+// @Summary Hits the oauth2 authorization api endpoint
+// @Description Issues an authorization code to an oauth client on behalf of the authenticated user
+// @Description and redirects back to the client. Responds with consent_required if the user has not
+// @Description yet consented to the requested scopes
+// @Tags HTTP API
+// @Produce json
+// @Param response_type query string true "response type, must be code"
+// @Param client_id query string true "client id"
+// @Param redirect_uri query string false "redirect uri"
+// @Param scope query string false "space delimited scopes"
+// @Param state query string false "state"
+// @Param code_challenge query string true "pkce code challenge"
+// @Param code_challenge_method query string true "pkce code challenge method, must be S256"
+// @Router /v1/oauth/authorize [get]
+// @Success 302
+func Authorize(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/oauth/authorize").Handler(httptransport.NewServer(
+		e.AuthorizeEndpoint,
+		decodeAuthorizeRequest,
+		encodeAuthorizeResponse,
+		options...,
+	))
+}
+
+// Grant Consent godoc
+// @Summary Hits the oauth2 consent api endpoint
+// @Description Records the consent of the authenticated user to the requested scopes and issues an
+// @Description authorization code. Accepts the same parameters as the authorization endpoint
+// @Tags HTTP API
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Router /v1/oauth/authorize [post]
+// @Success 302
+func GrantConsent(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/oauth/authorize").Handler(httptransport.NewServer(
+		e.AuthorizeEndpoint,
+		decodeAuthorizeRequest,
+		encodeAuthorizeResponse,
+		options...,
+	))
+}
+
+// Exchange Token godoc
+// @Summary Hits the oauth2 token api endpoint
+// @Description Exchanges an authorization code or a refresh token for a token pair. Clients
+// @Description authenticate through the basic authentication header or the request body
+// @Tags HTTP API
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Router /v1/oauth/token [post]
+// @Success 200
+func ExchangeToken(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/oauth/token").Handler(httptransport.NewServer(
+		e.ExchangeTokenEndpoint,
+		decodeExchangeTokenRequest,
+		encodeExchangeTokenResponse,
+		options...,
+	))
+}
+
+func decodeRegisterOAuthClientRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.RegisterOAuthClientRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// decodeAuthorizeRequest decodes an oauth2 authorization request from the query string or, when
+// consent is being granted, from a form-encoded request body
+func decodeAuthorizeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, utils.ErrInvalidRequest
+	}
+
+	return serviceendpoint.AuthorizeRequest{
+		Request: auth.AuthorizationRequest{
+			ResponseType:        r.Form.Get("response_type"),
+			ClientId:            r.Form.Get("client_id"),
+			RedirectURI:         r.Form.Get("redirect_uri"),
+			Scopes:              strings.Fields(r.Form.Get("scope")),
+			State:               r.Form.Get("state"),
+			CodeChallenge:       r.Form.Get("code_challenge"),
+			CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+		},
+		GrantConsent: r.Method == http.MethodPost,
+	}, nil
+}
+
+// decodeExchangeTokenRequest decodes a form-encoded oauth2 token request. Client credentials
+// supplied through the basic authentication header take precedence over the request body
+func decodeExchangeTokenRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, utils.ErrInvalidRequest
+	}
+
+	req := auth.TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientId:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+	}
+
+	if clientId, clientSecret, ok := r.BasicAuth(); ok {
+		req.ClientId = clientId
+		req.ClientSecret = clientSecret
+	}
+
+	return serviceendpoint.ExchangeTokenRequest{Request: req}, nil
+}
+
+// encodeAuthorizeResponse redirects the user agent back to the oauth client. Errors which cannot be
+// reported to the client are encoded like any other error
+func encodeAuthorizeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(serviceendpoint.AuthorizeResponse)
+	if resp.RedirectURI == "" {
+		if resp.Err != nil {
+			encodeError(ctx, resp.Err, w)
+			return nil
+		}
+		return encodeResponse(ctx, w, response)
+	}
+
+	w.Header().Set("Location", resp.RedirectURI)
+	w.WriteHeader(http.StatusFound)
+	return nil
+}
+
+// encodeExchangeTokenResponse encodes a token pair as described in RFC 6749 section 5.1
+func encodeExchangeTokenResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(serviceendpoint.TokenResponse)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	if resp.Err != nil {
+		encodeError(ctx, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(resp.Token)
+}
@@ -0,0 +1,90 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// Forgot Password godoc
+// @Summary Hits the forgot password api endpoint
+// @Description Emails a single use password reset link to the owner of an email address
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/password/forgot [post]
+// @Success 200
+func ForgotPassword(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/password/forgot").Handler(httptransport.NewServer(
+		e.ForgotPasswordEndpoint,
+		decodeForgotPasswordRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Reset Password godoc
+// @Summary Hits the reset password api endpoint
+// @Description Replaces the password of a user by means of a password reset token. Every session
+// @Description of the user is invalidated
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/password/reset [post]
+// @Success 200
+func ResetPassword(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/password/reset").Handler(httptransport.NewServer(
+		e.ResetPasswordEndpoint,
+		decodeResetPasswordRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Change Password godoc
+// @Summary Hits the change password api endpoint
+// @Description Replaces the password of the authenticated user once their current password is
+// @Description verified. Every session of the user is revoked
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/password [post]
+// @Success 200
+func ChangePassword(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/password").Handler(httptransport.NewServer(
+		e.ChangePasswordEndpoint,
+		decodeChangePasswordRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeForgotPasswordRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeResetPasswordRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeChangePasswordRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
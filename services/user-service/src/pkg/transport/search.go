@@ -0,0 +1,58 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+	user_service "github.com/LensPlatform/Lens/services/user-service/src/pkg/models/proto"
+)
+
+// Get Users By Type godoc
+// @Summary Hits the get users by type api endpoint
+// @Description Searches the active users of an account type, such as investors or startups, whose name,
+// @Description username or intent contain the query. Users whose username matches the query come first
+// @Tags HTTP API
+// @Produce json
+// @Param query query string false "text to search for, every user of the account type is returned when empty"
+// @Param max_results query int false "maximum amount of users returned"
+// @Param account_type query string false "RegularUser, Startup or Investor, defaults to RegularUser"
+// @Router /v1/users/search [get]
+// @Success 200
+func GetUsersByType(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/users/search").Handler(httptransport.NewServer(
+		e.GetUsersByTypeEndpoint,
+		decodeGetUsersByTypeRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeGetUsersByTypeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	params := r.URL.Query()
+	req := serviceendpoint.GetUsersByTypeRequest{QueryMessage: params.Get("query")}
+
+	if value := params.Get("max_results"); value != "" {
+		maxResults, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, utils.ErrInvalidArgumentProvided
+		}
+		req.MaxResults = int32(maxResults)
+	}
+
+	if value := params.Get("account_type"); value != "" {
+		accountType, ok := user_service.AccountType_value[value]
+		if !ok {
+			return nil, utils.ErrInvalidArgumentProvided
+		}
+		req.AccountType = user_service.AccountType(accountType)
+	}
+
+	return req, nil
+}
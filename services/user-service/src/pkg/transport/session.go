@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// List Sessions godoc
+// @Summary Hits the list sessions api endpoint
+// @Description Lists the active sessions of the authenticated user alongside the device and address
+// @Description they were last seen from
+// @Tags HTTP API
+// @Produce json
+// @Router /v1/user/sessions [get]
+// @Success 200
+func ListSessions(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/user/sessions").Handler(httptransport.NewServer(
+		e.ListSessionsEndpoint,
+		decodeEmptyRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Revoke Session godoc
+// @Summary Hits the revoke session api endpoint
+// @Description Ends a session of the authenticated user
+// @Tags HTTP API
+// @Produce json
+// @Param id path int true "session id"
+// @Router /v1/user/sessions/{id} [delete]
+// @Success 200
+func RevokeSession(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/user/sessions/{id}").Handler(httptransport.NewServer(
+		e.RevokeSessionEndpoint,
+		decodeRevokeSessionRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Revoke All Sessions godoc
+// @Summary Hits the revoke all sessions api endpoint
+// @Description Signs the authenticated user out everywhere, including from the current session
+// @Tags HTTP API
+// @Produce json
+// @Router /v1/user/sessions [delete]
+// @Success 200
+func RevokeAllSessions(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/user/sessions").Handler(httptransport.NewServer(
+		e.RevokeAllSessionsEndpoint,
+		decodeEmptyRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeRevokeSessionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.RevokeSessionRequest{Id: id}, nil
+}
@@ -0,0 +1,270 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+	utils "github.com/LensPlatform/Lens/services/user-service/src/pkg/helper"
+)
+
+// Create Team godoc
+// @Summary Hits the create team api endpoint
+// @Description Creates a team administered by the authenticated user. The password of the team,
+// @Description if any, is hashed prior to being persisted
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/team/create [post]
+// @Success 200
+func CreateTeam(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/team/create").Handler(httptransport.NewServer(
+		e.CreateTeamEndpoint,
+		decodeCreateTeamRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Update Team godoc
+// @Summary Hits the update team api endpoint
+// @Description Updates the descriptive fields of a team on behalf of one of its owners or admins or of
+// @Description the team itself. The email and password of the team are changed through its credentials
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Team id"
+// @Router /v1/team/{id} [put]
+// @Success 200
+func UpdateTeam(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("PUT").Path("/v1/team/{id}").Handler(httptransport.NewServer(
+		e.UpdateTeamEndpoint,
+		decodeUpdateTeamRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Change Team Credentials godoc
+// @Summary Hits the change team credentials api endpoint
+// @Description Replaces the email and password of a team. Owners of the team may omit its current
+// @Description password, admins of the team must provide it. Every session of the team is invalidated
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Team id"
+// @Router /v1/team/{id}/credentials [put]
+// @Success 200
+func ChangeTeamCredentials(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("PUT").Path("/v1/team/{id}/credentials").Handler(httptransport.NewServer(
+		e.ChangeTeamCredentialsEndpoint,
+		decodeChangeTeamCredentialsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Delete Team godoc
+// @Summary Hits the delete team api endpoint
+// @Description Deletes a team alongside every role held on it. Only owners of the team may delete it
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Team id"
+// @Router /v1/team/{id} [delete]
+// @Success 200
+func DeleteTeam(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/team/{id}").Handler(httptransport.NewServer(
+		e.DeleteTeamEndpoint,
+		decodeDeleteTeamRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Set Team Member godoc
+// @Summary Hits the set team member api endpoint
+// @Description Grants one of the owner, admin, member or advisor roles on a team to a user. Granting
+// @Description or revoking the owner and admin roles is restricted to owners
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Team id"
+// @Param user_id path int true "User id"
+// @Router /v1/team/{id}/members/{user_id} [put]
+// @Success 200
+func SetTeamMember(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("PUT").Path("/v1/team/{id}/members/{user_id}").Handler(httptransport.NewServer(
+		e.SetTeamMemberEndpoint,
+		decodeSetTeamMemberRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Remove Team Member godoc
+// @Summary Hits the remove team member api endpoint
+// @Description Revokes the role a user holds on a team. Users may always leave a team on their own
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Param id path int true "Team id"
+// @Param user_id path int true "User id"
+// @Router /v1/team/{id}/members/{user_id} [delete]
+// @Success 200
+func RemoveTeamMember(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("DELETE").Path("/v1/team/{id}/members/{user_id}").Handler(httptransport.NewServer(
+		e.RemoveTeamMemberEndpoint,
+		decodeRemoveTeamMemberRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Team Log In godoc
+// @Summary Hits the team log in api endpoint
+// @Description Authenticates a team with its own credentials and issues an access and refresh
+// @Description token pair scoped to the team
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/auth/team/token [post]
+// @Success 200
+func TeamLogIn(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/auth/team/token").Handler(httptransport.NewServer(
+		e.TeamLoginEndpoint,
+		decodeTeamLoginRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Forgot Team Password godoc
+// @Summary Hits the forgot team password api endpoint
+// @Description Emails a single use password reset link to the team owning an email address
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/team/password/forgot [post]
+// @Success 200
+func ForgotTeamPassword(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/team/password/forgot").Handler(httptransport.NewServer(
+		e.ForgotTeamPasswordEndpoint,
+		decodeForgotPasswordRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Reset Team Password godoc
+// @Summary Hits the reset team password api endpoint
+// @Description Replaces the password of a team by means of a password reset token. Every session
+// @Description of the team is invalidated
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/team/password/reset [post]
+// @Success 200
+func ResetTeamPassword(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/team/password/reset").Handler(httptransport.NewServer(
+		e.ResetTeamPasswordEndpoint,
+		decodeResetPasswordRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateTeamRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.CreateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeUpdateTeamRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req serviceendpoint.UpdateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	// the team id may be omitted from the body but must otherwise match the route
+	if req.Team.Id != 0 && req.Team.Id != id {
+		return nil, utils.ErrInconsistentIDs
+	}
+	req.Team.Id = id
+	return req, nil
+}
+
+func decodeChangeTeamCredentialsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req serviceendpoint.ChangeTeamCredentialsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	req.Id = id
+	return req, nil
+}
+
+func decodeDeleteTeamRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.DeleteTeamRequest{Id: id}, nil
+}
+
+func decodeSetTeamMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	teamId, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := decodeIdParam(r, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req serviceendpoint.SetTeamMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	req.TeamId = teamId
+	req.UserId = userId
+	return req, nil
+}
+
+func decodeRemoveTeamMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	teamId, err := decodeIdParam(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := decodeIdParam(r, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	return serviceendpoint.RemoveTeamMemberRequest{TeamId: teamId, UserId: userId}, nil
+}
+
+func decodeTeamLoginRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.TeamLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
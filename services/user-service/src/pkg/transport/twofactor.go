@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// Enroll Two Factor godoc
+// @Summary Hits the enroll two factor api endpoint
+// @Description Generates a pending two factor secret for the authenticated user. The returned otpauth
+// @Description uri is meant to be rendered as a QR code and scanned by an authenticator app
+// @Tags HTTP API
+// @Produce json
+// @Router /v1/user/2fa/enroll [post]
+// @Success 200
+func EnrollTwoFactor(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/2fa/enroll").Handler(httptransport.NewServer(
+		e.EnrollTwoFactorEndpoint,
+		decodeEmptyRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Confirm Two Factor godoc
+// @Summary Hits the confirm two factor api endpoint
+// @Description Enables two factor authentication once a code generated from the pending secret is
+// @Description presented and returns a set of single use recovery codes
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/2fa/confirm [post]
+// @Success 200
+func ConfirmTwoFactor(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/2fa/confirm").Handler(httptransport.NewServer(
+		e.ConfirmTwoFactorEndpoint,
+		decodeTwoFactorCodeRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Disable Two Factor godoc
+// @Summary Hits the disable two factor api endpoint
+// @Description Disables two factor authentication for the authenticated user given a valid one time
+// @Description password or recovery code
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/2fa/disable [post]
+// @Success 200
+func DisableTwoFactor(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/2fa/disable").Handler(httptransport.NewServer(
+		e.DisableTwoFactorEndpoint,
+		decodeTwoFactorCodeRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Verify Two Factor godoc
+// @Summary Hits the two factor log in api endpoint
+// @Description Completes the log in of a user with two factor authentication enabled by exchanging the
+// @Description challenge issued by the token api endpoint and a one time password or recovery code
+// @Description for an access and refresh token pair
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/auth/2fa [post]
+// @Success 200
+func VerifyTwoFactor(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/auth/2fa").Handler(httptransport.NewServer(
+		e.VerifyTwoFactorEndpoint,
+		decodeVerifyTwoFactorRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeTwoFactorCodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeVerifyTwoFactorRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.VerifyTwoFactorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	serviceendpoint "github.com/LensPlatform/Lens/services/user-service/src/pkg/endpoint"
+)
+
+// Verify Email godoc
+// @Summary Hits the verify email api endpoint
+// @Description Activates the account a signed verification link was issued for
+// @Tags HTTP API
+// @Produce json
+// @Param token query string true "verification token"
+// @Router /v1/user/verify [get]
+// @Success 200
+func VerifyEmail(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("GET").Path("/v1/user/verify").Handler(httptransport.NewServer(
+		e.VerifyEmailEndpoint,
+		decodeVerifyEmailRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// Resend Verification godoc
+// @Summary Hits the resend verification api endpoint
+// @Description Sends a new verification link to the owner of an inactive account
+// @Tags HTTP API
+// @Accept json
+// @Produce json
+// @Router /v1/user/verify/resend [post]
+// @Success 200
+func ResendVerification(r *mux.Router, e serviceendpoint.Set, options []httptransport.ServerOption) *mux.Route {
+	return r.Methods("POST").Path("/v1/user/verify/resend").Handler(httptransport.NewServer(
+		e.ResendVerificationEndpoint,
+		decodeResendVerificationRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeVerifyEmailRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return serviceendpoint.VerifyEmailRequest{Token: r.URL.Query().Get("token")}, nil
+}
+
+func decodeResendVerificationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req serviceendpoint.ResendVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}